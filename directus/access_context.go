@@ -1,7 +1,12 @@
 package directus
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"reflect"
+	"strings"
 	"sync"
 	"time"
 )
@@ -10,6 +15,14 @@ type DirectusAccessContext struct {
 	trackingObjects      map[IDirectusObject]trackingRef
 	trackingObjectsMutex sync.Mutex
 	api                  *DirectusApi
+	concurrency          *ConcurrencyOptions
+	pendingInserts       []IDirectusObject
+	pendingDeletes       []IDirectusObject
+	dryRun               bool
+	continueOnError      bool
+	saga                 bool
+	validate             bool
+	ctx                  context.Context
 }
 
 func (h *DirectusApi) NewDirectusAccessContext() *DirectusAccessContext {
@@ -19,10 +32,18 @@ func (h *DirectusApi) NewDirectusAccessContext() *DirectusAccessContext {
 	}
 }
 
+// Nil context tracks nothing, so reading with nil context returns detached objects
 func (h *DirectusAccessContext) add2Track(val any) bool {
+	if h == nil {
+		return false
+	}
 	h.trackingObjectsMutex.Lock()
 	defer h.trackingObjectsMutex.Unlock()
-	objects := val.(IDirectusObject).Track()
+	return h.add2TrackLocked(val)
+}
+
+func (h *DirectusAccessContext) add2TrackLocked(val any) bool {
+	objects := trackedObjects(val.(IDirectusObject))
 	objects = append(objects, val.(IDirectusObject))
 	for _, obj := range objects {
 		_, exists := h.trackingObjects[obj]
@@ -44,35 +65,355 @@ func (h *DirectusAccessContext) add2Track(val any) bool {
 	return false
 }
 
+// Add schedules creation of new object on SaveChanges
+func (h *DirectusAccessContext) Add(obj IDirectusObject) error {
+	err := checkPointer(obj)
+	if err != nil {
+		return err
+	}
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	_, exists := h.api.collectionsAccessors[obj.CollectionName()]
+	if !exists {
+		return fmt.Errorf("collection accessor for object: %s not exists in map", obj.CollectionName())
+	}
+	h.pendingInserts = append(h.pendingInserts, obj)
+	return nil
+}
+
+// Remove schedules deletion of object on SaveChanges, its pending changes are not saved
+func (h *DirectusAccessContext) Remove(obj IDirectusObject) error {
+	err := checkPointer(obj)
+	if err != nil {
+		return err
+	}
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	_, exists := h.api.collectionsAccessors[obj.CollectionName()]
+	if !exists {
+		return fmt.Errorf("collection accessor for object: %s not exists in map", obj.CollectionName())
+	}
+	h.pendingDeletes = append(h.pendingDeletes, obj)
+	return nil
+}
+
+// UseContext makes operations of context, like SaveChanges and LoadById, run with ctx:
+// requests are cancelled with it and spans become children of spans carried by it
+func (h *DirectusAccessContext) UseContext(ctx context.Context) {
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	h.ctx = ctx
+}
+
+// Nil context runs with background context
+func (h *DirectusAccessContext) context() context.Context {
+	if h == nil || h.ctx == nil {
+		return context.Background()
+	}
+	return h.ctx
+}
+
+// UseDryRun makes SaveChanges log pending changes instead of sending them, tracking state is kept
+func (h *DirectusAccessContext) UseDryRun(enabled bool) {
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	h.dryRun = enabled
+}
+
+func (h *DirectusAccessContext) isPendingDelete(obj IDirectusObject) bool {
+	for _, d := range h.pendingDeletes {
+		if d == obj {
+			return true
+		}
+	}
+	return false
+}
+
+// Values of new object, nil and zero values including zero id are omitted,
+// so directus applies column defaults. Use Optional to send zero value explicitly
+func insertPayload(obj IDirectusObject) map[string]any {
+	payload := objectPayload(obj)
+	for name, value := range payload {
+		if value == nil || reflect.ValueOf(value).IsZero() {
+			delete(payload, name)
+		}
+	}
+	return payload
+}
+
+// Values of object including its key, many-to-one relations are sent as keys,
+// new related objects are created together with object
+func objectPayload(obj IDirectusObject) map[string]any {
+	payload := dropUnset(obj.Map())
+	if payload == nil {
+		payload = map[string]any{}
+	}
+	// Unset relations are left to directus defaults
+	for name, related := range relatedObjects(obj) {
+		if _, exists := payload[name]; !exists && related != nil {
+			payload[name] = relationPayload(related)
+		}
+	}
+	for name, field := range relationalFields(obj) {
+		if rows := field.RelationalCreate(); rows != nil {
+			payload[name] = rows
+		}
+	}
+	return payload
+}
+
+// Many-to-one relation fields of object by json name, nil relations included
+func relatedObjects(obj IDirectusObject) map[string]IDirectusObject {
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	result := map[string]IDirectusObject{}
+	if v.Kind() != reflect.Struct {
+		return result
+	}
+	objectType := reflect.TypeOf((*IDirectusObject)(nil)).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Anonymous || !f.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		if f.Type.Kind() != reflect.Pointer || !f.Type.Implements(objectType) {
+			continue
+		}
+		if v.Field(i).IsNil() {
+			result[name] = nil
+		} else {
+			result[name] = v.Field(i).Interface().(IDirectusObject)
+		}
+	}
+	return result
+}
+
+func relationPayload(related IDirectusObject) any {
+	if related == nil {
+		return nil
+	}
+	if id, exists := related.Map()["id"]; exists {
+		if !reflect.ValueOf(id).IsZero() {
+			return id
+		}
+		return insertPayload(related)
+	}
+	return related.GetId()
+}
+
+func checkPointer(obj IDirectusObject) error {
+	if obj == nil || reflect.ValueOf(obj).Kind() != reflect.Pointer || reflect.ValueOf(obj).IsNil() {
+		return fmt.Errorf("object of type %T must be a non-nil pointer", obj)
+	}
+	return nil
+}
+
 func (h *DirectusAccessContext) SaveChanges() error {
+	_, err := h.SaveChangesWithReport()
+	return err
+}
+
+// SaveChangesWithReport saves changes and reports result of every affected object.
+// Successfully saved objects are not sent again when SaveChanges is retried after a failure
+func (h *DirectusAccessContext) SaveChangesWithReport() (_ *SaveReport, err error) {
 	h.trackingObjectsMutex.Lock()
 	defer h.trackingObjectsMutex.Unlock()
-	affectedObjects := 0
+	report := &SaveReport{Results: []SaveResult{}}
+	if h.dryRun {
+		changes, err := json.Marshal(h.changeSet())
+		if err != nil {
+			return nil, err
+		}
+		h.api.infoLogger.Printf("Dry run, changes are not saved: %s\n", changes)
+		return report, nil
+	}
+	op := h.api.startOperation(h.context(), "", "SaveChanges")
+	defer func() { op.end(err) }()
 	startTime := time.Now()
+	undo := []compensation{}
+
+	failed := func(result SaveResult) bool {
+		report.Results = append(report.Results, result)
+		if result.Err == nil {
+			return false
+		}
+		h.api.errLogger.Printf("Failed to %s object of type [%s]: %s\n", result.Action, result.Collection, result.Err.Error())
+		return !h.continueOnError
+	}
+
+	inserts := h.pendingInserts
+	h.pendingInserts = nil
+	for i, obj := range inserts {
+		result := SaveResult{Collection: obj.CollectionName(), Action: SAVE_CREATE, Object: obj}
+		var created IDirectusObject
+		result.Err = runBeforeCreate(obj)
+		if result.Err == nil {
+			result.Err = validateEnums(obj.CollectionName(), insertPayload(obj))
+		}
+		if result.Err == nil && h.validate {
+			result.Err = h.api.validatePayload(op.ctx, obj.CollectionName(), insertPayload(obj), true)
+		}
+		if result.Err == nil {
+			created, result.Err = h.api.collectionsAccessors[obj.CollectionName()].create(op.ctx, insertPayload(obj))
+		}
+		if result.Err != nil {
+			h.pendingInserts = append(h.pendingInserts, obj)
+		} else {
+			copyFields(obj, created, created.Map())
+			result.Id = obj.GetId()
+			h.add2TrackLocked(obj)
+			undo = append(undo, h.undoCreate(result))
+			runAfterSave(obj)
+		}
+		if failed(result) {
+			h.pendingInserts = append(h.pendingInserts, inserts[i+1:]...)
+			return report, h.compensate(op.ctx, report, undo)
+		}
+	}
 	for _, obj := range h.trackingObjects {
+		if h.isPendingDelete(obj.Actual) {
+			continue
+		}
+		if obj.delta() == nil {
+			continue
+		}
+		result := SaveResult{Collection: obj.Original.CollectionName(), Id: obj.Original.GetId(), Action: SAVE_PATCH, Object: obj.Actual}
+		result.Err = runBeforeUpdate(obj.Actual)
 		diff := obj.delta()
-		if diff != nil {
-			cas := obj.OwnerCollection
-			err := cas.patch(diff, obj.Original.GetId())
-			if err != nil {
-				h.api.errLogger.Printf("Failed to save changes for object of type [%s]: %s\n", obj.Original.CollectionName(), err.Error())
-				return err
+		if result.Err == nil && diff != nil {
+			result.Err = validateEnums(obj.Original.CollectionName(), diff)
+		}
+		if result.Err == nil && diff != nil && h.validate {
+			result.Err = h.api.validatePayload(op.ctx, obj.Original.CollectionName(), diff, false)
+		}
+		if result.Err == nil && diff != nil {
+			diff, result.Err = h.resolveConcurrency(op.ctx, obj, diff)
+		}
+		if result.Err == nil && diff != nil {
+			result.Err = obj.OwnerCollection.patch(op.ctx, diff, obj.Original.GetId())
+		}
+		if result.Err == nil {
+			if diff != nil {
+				undo = append(undo, h.undoPatch(result, obj, diff))
 			}
-			affectedObjects++
+			obj.Original = obj.Actual.DeepCopy()
+			h.trackingObjects[obj.Actual] = obj
+			runAfterSave(obj.Actual)
+		}
+		if failed(result) {
+			return report, h.compensate(op.ctx, report, undo)
 		}
 	}
+	deletes := h.pendingDeletes
+	h.pendingDeletes = nil
+	for i, obj := range deletes {
+		result := SaveResult{Collection: obj.CollectionName(), Id: obj.GetId(), Action: SAVE_DELETE, Object: obj}
+		var snapshot IDirectusObject
+		result.Err = runBeforeDelete(obj)
+		if result.Err == nil && h.saga {
+			// Stored row is needed to recreate it with all of its relations
+			snapshot, result.Err = h.api.collectionsAccessors[obj.CollectionName()].load(op.ctx, obj.GetId())
+		}
+		if result.Err == nil {
+			result.Err = h.api.collectionsAccessors[obj.CollectionName()].delete(op.ctx, obj.GetId())
+		}
+		if result.Err != nil {
+			h.pendingDeletes = append(h.pendingDeletes, obj)
+		} else {
+			if h.saga {
+				undo = append(undo, h.undoDelete(result, snapshot))
+			}
+			delete(h.trackingObjects, obj)
+		}
+		if failed(result) {
+			h.pendingDeletes = append(h.pendingDeletes, deletes[i+1:]...)
+			return report, h.compensate(op.ctx, report, undo)
+		}
+	}
+
+	err = h.compensate(op.ctx, report, undo)
+	if err != nil {
+		return report, err
+	}
 	for io := range h.trackingObjects {
 		delete(h.trackingObjects, io)
 	}
+	op.span.SetAttribute(ATTR_ITEM_COUNT, len(report.Results))
 	deltaTime := time.Since(startTime)
-	h.api.infoLogger.Printf("Changes saved, affected [%d] objects, %s\n", affectedObjects, deltaTime)
-	return nil
+	h.api.infoLogger.Printf("Changes saved, affected [%d] objects, %s\n", len(report.Results), deltaTime)
+	return report, nil
+}
+
+// UseValidation makes SaveChanges validate created and changed objects against directus field metadata before sending them
+func (h *DirectusAccessContext) UseValidation(enabled bool) {
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	h.validate = enabled
+}
+
+// UseContinueOnError makes SaveChanges try every object instead of stopping at the first failure
+func (h *DirectusAccessContext) UseContinueOnError(enabled bool) {
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	h.continueOnError = enabled
+}
+
+// Attach starts tracking changes of detached object and its related objects, current state is used as original
+func (h *DirectusAccessContext) Attach(obj IDirectusObject) {
+	h.add2Track(obj)
+}
+
+// Detach stops tracking changes of object and of its related objects no other tracked object refers to,
+// pending changes of detached objects are not saved
+func (h *DirectusAccessContext) Detach(obj IDirectusObject) {
+	if h == nil {
+		return
+	}
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	detached := map[IDirectusObject]bool{}
+	for _, related := range trackedObjects(obj) {
+		detached[related] = true
+	}
+	// Related objects are listed transitively, so one pass finds everything still reachable
+	for tracked := range h.trackingObjects {
+		if tracked == obj || detached[tracked] {
+			continue
+		}
+		for _, related := range trackedObjects(tracked) {
+			delete(detached, related)
+		}
+	}
+	delete(h.trackingObjects, obj)
+	for related := range detached {
+		if related != obj {
+			delete(h.trackingObjects, related)
+		}
+	}
+}
+
+func (h *DirectusAccessContext) IsTracked(obj IDirectusObject) bool {
+	if h == nil {
+		return false
+	}
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	_, exists := h.trackingObjects[obj]
+	return exists
 }
 
 func (h *DirectusAccessContext) Clear() {
+	if h == nil {
+		return
+	}
 	h.trackingObjectsMutex.Lock()
 	defer h.trackingObjectsMutex.Unlock()
 	for io := range h.trackingObjects {
 		delete(h.trackingObjects, io)
 	}
+	h.pendingInserts = nil
+	h.pendingDeletes = nil
 }
@@ -0,0 +1,50 @@
+package directus
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDetachKeepsSharedRelations(t *testing.T) {
+	api := newTestApi(t, http.NotFoundHandler())
+	ctx := api.NewDirectusAccessContext()
+	product := &Product{Id: uuid.New()}
+	first := &Slot{Id: uuid.New(), Product: product}
+	second := &Slot{Id: uuid.New(), Product: product}
+	ctx.Attach(first)
+	ctx.Attach(second)
+
+	ctx.Detach(first)
+	if ctx.IsTracked(first) {
+		t.Error("detached slot is still tracked")
+	}
+	if !ctx.IsTracked(product) {
+		t.Error("product referenced by tracked slot was detached")
+	}
+	ctx.Detach(second)
+	if ctx.IsTracked(product) {
+		t.Error("product referenced by nothing is still tracked")
+	}
+}
+
+func TestNilAccessContext(t *testing.T) {
+	var ctx *DirectusAccessContext
+	slot := &Slot{}
+	ctx.Attach(slot)
+	ctx.Detach(slot)
+	ctx.Clear()
+	if ctx.IsTracked(slot) {
+		t.Error("nil context tracks objects")
+	}
+}
+
+func TestInsertPayloadLeavesZeroValuesToDefaults(t *testing.T) {
+	annotation := "note"
+	product := &Product{Id: uuid.New()}
+	payload := insertPayload(&Slot{Annotation: &annotation, Product: product})
+	if len(payload) != 2 || payload["product"] != product.Id {
+		t.Errorf("payload = %v, want annotation and product only", payload)
+	}
+}
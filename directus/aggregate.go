@@ -0,0 +1,118 @@
+package directus
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type AggregateFunction string
+
+const (
+	AGGREGATE_COUNT          = AggregateFunction("count")
+	AGGREGATE_COUNT_DISTINCT = AggregateFunction("countDistinct")
+	AGGREGATE_SUM            = AggregateFunction("sum")
+	AGGREGATE_SUM_DISTINCT   = AggregateFunction("sumDistinct")
+	AGGREGATE_AVG            = AggregateFunction("avg")
+	AGGREGATE_AVG_DISTINCT   = AggregateFunction("avgDistinct")
+	AGGREGATE_MIN            = AggregateFunction("min")
+	AGGREGATE_MAX            = AggregateFunction("max")
+)
+
+type FieldFunction string
+
+const (
+	FUNC_YEAR    = FieldFunction("year")
+	FUNC_MONTH   = FieldFunction("month")
+	FUNC_WEEK    = FieldFunction("week")
+	FUNC_DAY     = FieldFunction("day")
+	FUNC_WEEKDAY = FieldFunction("weekday")
+	FUNC_HOUR    = FieldFunction("hour")
+	FUNC_MINUTE  = FieldFunction("minute")
+	FUNC_SECOND  = FieldFunction("second")
+	FUNC_COUNT   = FieldFunction("count")
+)
+
+// Of returns field selector with applied function, e.g. FUNC_YEAR.Of("date_created") is "year(date_created)"
+func (h FieldFunction) Of(field string) string {
+	return fmt.Sprintf("%s(%s)", h, field)
+}
+
+// Aggregate adds aggregation of fields, use "*" with AGGREGATE_COUNT to count rows
+func (h *CollectionQuery[K, V]) Aggregate(function AggregateFunction, field ...string) *CollectionQuery[K, V] {
+	if h.aggregates == nil {
+		h.aggregates = map[AggregateFunction][]string{}
+	}
+	for _, s := range field {
+		s = strings.ReplaceAll(s, " ", "")
+		h.aggregates[function] = append(h.aggregates[function], strings.Split(s, ",")...)
+	}
+	return h
+}
+
+// GroupBy groups aggregation results, accepts plain fields and functions like FUNC_YEAR.Of("date_created")
+func (h *CollectionQuery[K, V]) GroupBy(field ...string) *CollectionQuery[K, V] {
+	for _, s := range field {
+		s = strings.ReplaceAll(s, " ", "")
+		h.groupBy = append(h.groupBy, strings.Split(s, ",")...)
+	}
+	return h
+}
+
+func (h *CollectionQuery[K, V]) fetchAggregate() (_ json.RawMessage, err error) {
+	op := h.Collection.api.startOperation(h.context(), h.Collection.collectionName, "Aggregate")
+	defer func() { op.end(err) }()
+
+	if len(h.aggregates) == 0 {
+		return nil, fmt.Errorf("no aggregate functions specified")
+	}
+	q, err := h.buildQuery(op)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are groups, fields of items do not apply
+	q.Del("fields")
+	q.Del("deep")
+	for alias := range h.aliases {
+		q.Del(fmt.Sprintf("alias[%s]", alias))
+	}
+	// Every group is returned unless limited explicitly, directus limits to 100 rows by default
+	if h.limit == nil {
+		q.Set("limit", "-1")
+	}
+	for function, fields := range h.aggregates {
+		q.Set(fmt.Sprintf("aggregate[%s]", function), strings.Join(fields, ","))
+	}
+	if len(h.groupBy) != 0 {
+		q.Set("groupBy", strings.Join(h.groupBy, ","))
+	}
+
+	item := DirectusResponse[json.RawMessage]{}
+	err = h.fetch(op, q, &item)
+	if err != nil {
+		return nil, err
+	}
+	return item.Data, nil
+}
+
+// ToAggregateMaps executes aggregation, each row holds group fields and
+// aggregate results keyed by function, e.g. {"product": "...", "sum": {"price": 10}}
+func (h *CollectionQuery[K, V]) ToAggregateMaps() ([]map[string]any, error) {
+	return AggregateAs[map[string]any](h)
+}
+
+// AggregateAs executes aggregation of query and decodes rows into T
+func AggregateAs[T any, K string | uuid.UUID | int, V IDirectusObject](query *CollectionQuery[K, V]) ([]T, error) {
+	data, err := query.fetchAggregate()
+	if err != nil {
+		return nil, err
+	}
+	result := []T{}
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
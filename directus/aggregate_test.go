@@ -0,0 +1,42 @@
+package directus
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestAggregatePassesPaging(t *testing.T) {
+	var query url.Values
+	api := newTestApi(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		io.WriteString(w, `{"data":[{"product":"a","sum":{"price":"10"}}]}`)
+	}))
+	rows, err := api.SlotCollectionAccessor.ReadAll().
+		Aggregate(AGGREGATE_SUM, "price").GroupBy("product").Sort("-sum.price").Offset(5).
+		ToAggregateMaps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("rows = %v", rows)
+	}
+	want := map[string]string{"limit": "-1", "offset": "5", "sort": "-sum.price", "groupBy": "product", "aggregate[sum]": "price"}
+	for k, v := range want {
+		if query.Get(k) != v {
+			t.Errorf("%s = %q, want %q", k, query.Get(k), v)
+		}
+	}
+	if query.Has("fields") {
+		t.Errorf("fields must not be sent with aggregate")
+	}
+
+	_, err = api.SlotCollectionAccessor.ReadAll().Aggregate(AGGREGATE_COUNT, "*").Limit(10).ToAggregateMaps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("limit") != "10" {
+		t.Errorf("limit = %q, want 10", query.Get("limit"))
+	}
+}
@@ -0,0 +1,110 @@
+package directus
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Item with values of selected fields that are not part of the item type, e.g. aliases and function fields
+type ItemWithExtras[V IDirectusObject] struct {
+	Item   *V
+	Extras map[string]any
+}
+
+// Alias requests field under another name, e.g. to load the same relation twice with different Deep queries
+func (h *CollectionQuery[K, V]) Alias(alias, field string) *CollectionQuery[K, V] {
+	if h.aliases == nil {
+		h.aliases = map[string]string{}
+	}
+	h.aliases[alias] = field
+	h.fieldSelectors = append(h.fieldSelectors, alias)
+	return h
+}
+
+// IncludeFunction selects result of function applied to field, e.g. FUNC_COUNT for "panels"
+func (h *CollectionQuery[K, V]) IncludeFunction(function FieldFunction, field ...string) *CollectionQuery[K, V] {
+	for _, f := range field {
+		h.fieldSelectors = append(h.fieldSelectors, function.Of(strings.ReplaceAll(f, " ", "")))
+	}
+	return h
+}
+
+// ToSliceWithExtras works like ToSlice, keeping values that do not fit into V in Extras
+func (h *CollectionQuery[K, V]) ToSliceWithExtras(accessContext *DirectusAccessContext) (_ []ItemWithExtras[V], err error) {
+	op := h.Collection.api.startOperation(h.context(), h.Collection.collectionName, "ToSliceWithExtras")
+	defer func() { op.end(err) }()
+
+	q, err := h.buildQuery(op)
+	if err != nil {
+		return nil, err
+	}
+	item := DirectusResponse[[]json.RawMessage]{}
+	err = h.fetch(op, q, &item)
+	if err != nil {
+		return nil, err
+	}
+
+	op.span.SetAttribute(ATTR_ITEM_COUNT, len(item.Data))
+	known := jsonFieldNames(reflect.TypeOf((*V)(nil)).Elem())
+	result := make([]ItemWithExtras[V], 0, len(item.Data))
+	for _, raw := range item.Data {
+		obj := new(V)
+		err = json.Unmarshal(raw, obj)
+		if err != nil {
+			return nil, err
+		}
+		values := map[string]any{}
+		err = json.Unmarshal(raw, &values)
+		if err != nil {
+			return nil, err
+		}
+		for k := range values {
+			if known[k] {
+				delete(values, k)
+			}
+		}
+		h.track(accessContext, obj)
+		result = append(result, ItemWithExtras[V]{Item: obj, Extras: values})
+	}
+	return result, nil
+}
+
+// ToSliceAs decodes query results into T as is, without change tracking
+func ToSliceAs[T any, K string | uuid.UUID | int, V IDirectusObject](query *CollectionQuery[K, V]) (_ []T, err error) {
+	op := query.Collection.api.startOperation(query.context(), query.Collection.collectionName, "ToSliceAs")
+	defer func() { op.end(err) }()
+
+	q, err := query.buildQuery(op)
+	if err != nil {
+		return nil, err
+	}
+	item := DirectusResponse[[]T]{}
+	err = query.fetch(op, q, &item)
+	if err != nil {
+		return nil, err
+	}
+	op.span.SetAttribute(ATTR_ITEM_COUNT, len(item.Data))
+	return item.Data, nil
+}
+
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names[name] = true
+	}
+	return names
+}
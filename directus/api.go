@@ -1,6 +1,7 @@
 package directus
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -8,17 +9,40 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+type DirectusMeta struct {
+	TotalCount  int `json:"total_count"`
+	FilterCount int `json:"filter_count"`
+}
+
 type DirectusResponse[T any] struct {
-	Data   T `json:"data"`
+	Data   T             `json:"data"`
+	Meta   *DirectusMeta `json:"meta"`
 	Errors []struct {
 		Message string `json:"message"`
 	} `json:"errors"`
 }
 
+type directusErrorsHolder interface {
+	err() error
+}
+
+func (h *DirectusResponse[T]) err() error {
+	if h.Errors != nil {
+		msg := ""
+		if len(h.Errors) != 0 {
+			msg = h.Errors[0].Message
+		}
+		return fmt.Errorf(msg)
+	}
+	return nil
+}
+
 type trackingRef struct {
 	Original        IDirectusObject
 	Actual          IDirectusObject
@@ -26,7 +50,18 @@ type trackingRef struct {
 }
 
 func (h trackingRef) delta() map[string]any {
-	return h.Actual.(IDirectusObject).Diff(h.Original.(IDirectusObject))
+	diff := h.Actual.(IDirectusObject).Diff(h.Original.(IDirectusObject))
+	if diff == nil {
+		diff = map[string]any{}
+	}
+	dropUnset(diff)
+	for name, change := range relationalDiff(h.Actual, h.Original) {
+		diff[name] = change
+	}
+	if len(diff) == 0 {
+		return nil
+	}
+	return diff
 }
 
 type DirectusApi struct {
@@ -36,6 +71,19 @@ type DirectusApi struct {
 	errLogger  *log.Logger
 	infoLogger *log.Logger
 
+	// Guards tracer, metrics and middlewares, they may be replaced while requests are running
+	pipelineMutex sync.RWMutex
+	tracer        IDirectusTracer
+	metrics       IDirectusMetrics
+	middlewares   []DirectusMiddleware
+
+	skipStartupPing       bool
+	healthMonitorInterval time.Duration
+	monitor               healthMonitor
+
+	validation      map[string][]fieldRules
+	validationMutex sync.Mutex
+
 	DirectusActivityCollectionAccessor      *DirectusCollectionAccessor[int, DirectusActivity]
 	DirectusDashboardsCollectionAccessor    *DirectusCollectionAccessor[uuid.UUID, DirectusDashboards]
 	DirectusExtensionsCollectionAccessor    *DirectusCollectionAccessor[uuid.UUID, DirectusExtensions]
@@ -67,7 +115,49 @@ type DirectusApi struct {
 	collectionsAccessors map[string]IDirectusCollectionAccessor
 }
 
-func New(addr, token string) (*DirectusApi, error) {
+type DirectusOption func(*DirectusApi)
+
+// Do not fail New when directus is not reachable yet, e.g. while it is booting
+func WithoutStartupPing() DirectusOption {
+	return func(h *DirectusApi) {
+		h.skipStartupPing = true
+	}
+}
+
+// Registers middlewares before any request is issued, see Use
+func WithMiddleware(middleware ...DirectusMiddleware) DirectusOption {
+	return func(h *DirectusApi) {
+		h.Use(middleware...)
+	}
+}
+
+func WithTracer(tracer IDirectusTracer) DirectusOption {
+	return func(h *DirectusApi) {
+		h.UseTracer(tracer)
+	}
+}
+
+func WithMetrics(metrics IDirectusMetrics) DirectusOption {
+	return func(h *DirectusApi) {
+		h.UseMetrics(metrics)
+	}
+}
+
+// Starts health monitor at the end of New, after every option is applied, see StartHealthMonitor
+func WithHealthMonitor(interval time.Duration) DirectusOption {
+	return func(h *DirectusApi) {
+		h.healthMonitorInterval = interval
+	}
+}
+
+// Registers health callback before monitor started by WithHealthMonitor polls the first time, see OnHealthChanged
+func WithHealthChangedCallback(callback HealthChangedCallback) DirectusOption {
+	return func(h *DirectusApi) {
+		h.OnHealthChanged(callback)
+	}
+}
+
+func New(addr, token string, options ...DirectusOption) (*DirectusApi, error) {
 	u, err := url.Parse(addr)
 	if err != nil {
 		return nil, err
@@ -77,10 +167,17 @@ func New(addr, token string) (*DirectusApi, error) {
 		token:       token,
 		errLogger:   log.New(os.Stdout, "[DIRECTUS-API][ERROR]\t", log.Ltime),
 		infoLogger:  log.New(os.Stdout, "[DIRECTUS-API][INFO]\t", log.Ltime),
+		tracer:      noopTracer{},
+		metrics:     noopMetrics{},
 	}
-	err = h.PingDirectus()
-	if err != nil {
-		return nil, err
+	for _, option := range options {
+		option(h)
+	}
+	if !h.skipStartupPing {
+		err = h.PingDirectus()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	h.DirectusActivityCollectionAccessor = NewDirectusCollectionAccessor[int, DirectusActivity](h, "directus_activity")
@@ -141,21 +238,32 @@ func New(addr, token string) (*DirectusApi, error) {
 		"transaction":            h.TransactionCollectionAccessor,
 	}
 
+	// Started last, so options and accessors are in place before first poll
+	if h.healthMonitorInterval > 0 {
+		h.StartHealthMonitor(h.healthMonitorInterval)
+	}
 	return h, nil
 }
 
-func (h *DirectusApi) PingDirectus() error {
+func (h *DirectusApi) PingDirectus() (err error) {
+	op := h.startOperation(context.Background(), "", "PingDirectus")
+	defer func() { op.end(err) }()
+
 	addr := *h.directusUrl
 	addr.Path = path.Join(addr.Path, "/server/ping")
 
-	resp, err := http.Get(addr.String())
+	req, err := http.NewRequest("GET", addr.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := op.do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		h.errLogger.Printf("Directus ping failed: %s\n", err.Error())
+		h.errLogger.Printf("Directus ping failed: status code %d\n", resp.StatusCode)
 		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 	return nil
@@ -163,6 +271,7 @@ func (h *DirectusApi) PingDirectus() error {
 
 func NewDirectusCollectionAccessor[K string | uuid.UUID | int, V IDirectusObject](api *DirectusApi, collectionName string) *DirectusCollectionAccessor[K, V] {
 	api.infoLogger.Printf("Created collection accessor for %s\n", collectionName)
+	registerCollectionType(collectionName, func() IDirectusObject { return any(new(V)).(IDirectusObject) })
 	return &DirectusCollectionAccessor[K, V]{
 		api:            api,
 		collectionName: collectionName,
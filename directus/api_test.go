@@ -0,0 +1,97 @@
+package directus
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Api talking to handler, loggers are silenced
+func newTestApi(t *testing.T, handler http.Handler, options ...DirectusOption) *DirectusApi {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	silent := func(h *DirectusApi) {
+		h.errLogger = log.New(io.Discard, "", 0)
+		h.infoLogger = log.New(io.Discard, "", 0)
+	}
+	api, err := New(server.URL, "token", append([]DirectusOption{WithoutStartupPing(), silent}, options...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(api.StopHealthMonitor)
+	return api
+}
+
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/health+json")
+	io.WriteString(w, `{"status":"ok"}`)
+}
+
+func TestUseWhileRequestsAreRunning(t *testing.T) {
+	api := newTestApi(t, http.HandlerFunc(healthHandler), WithHealthMonitor(time.Millisecond))
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			api.Use(func(info DirectusRequestInfo, req *http.Request, next DirectusRoundTrip) (*http.Response, error) {
+				return next(req)
+			})
+			api.UseTracer(nil)
+			api.UseMetrics(nil)
+			_, _ = api.HealthCheck()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	calls := []string{}
+	mw := func(name string) DirectusMiddleware {
+		return func(info DirectusRequestInfo, req *http.Request, next DirectusRoundTrip) (*http.Response, error) {
+			calls = append(calls, name+">")
+			resp, err := next(req)
+			calls = append(calls, "<"+name)
+			return resp, err
+		}
+	}
+	api := newTestApi(t, http.HandlerFunc(healthHandler), WithMiddleware(mw("a")))
+	api.Use(mw("b"))
+	_, err := api.HealthCheck()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a>", "b>", "<b", "<a"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", calls, want)
+		}
+	}
+}
+
+func TestHealthCallbackSeesFirstCheck(t *testing.T) {
+	results := make(chan bool, 1)
+	failing := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		io.WriteString(w, `{"status":"error"}`)
+	})
+	newTestApi(t, failing, WithHealthMonitor(time.Hour), WithHealthChangedCallback(func(ready bool, health *DirectusHealth, err error) {
+		results <- ready
+	}))
+	select {
+	case ready := <-results:
+		if ready {
+			t.Fatal("first check reported ready for failing server")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("first health check was not reported")
+	}
+}
@@ -0,0 +1,82 @@
+package directus
+
+import (
+	"sort"
+)
+
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+type PatchChange struct {
+	Id     string                 `json:"id"`
+	Fields map[string]FieldChange `json:"fields"`
+}
+
+type CollectionChanges struct {
+	Inserts []map[string]any `json:"inserts,omitempty"`
+	Patches []PatchChange    `json:"patches,omitempty"`
+	Deletes []string         `json:"deletes,omitempty"`
+}
+
+// Pending changes of access context grouped by collection name
+type ChangeSet map[string]*CollectionChanges
+
+func (h ChangeSet) collection(name string) *CollectionChanges {
+	changes, exists := h[name]
+	if !exists {
+		changes = &CollectionChanges{}
+		h[name] = changes
+	}
+	return changes
+}
+
+func (h ChangeSet) IsEmpty() bool {
+	return len(h) == 0
+}
+
+// ChangeSet returns everything SaveChanges would send, without performing any requests
+func (h *DirectusAccessContext) ChangeSet() ChangeSet {
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	return h.changeSet()
+}
+
+func (h *DirectusAccessContext) changeSet() ChangeSet {
+	changes := ChangeSet{}
+	for _, obj := range h.pendingInserts {
+		c := changes.collection(obj.CollectionName())
+		c.Inserts = append(c.Inserts, insertPayload(obj))
+	}
+	for _, ref := range h.trackingObjects {
+		if h.isPendingDelete(ref.Actual) {
+			continue
+		}
+		diff := ref.delta()
+		if diff == nil {
+			continue
+		}
+		original := ref.Original.Map()
+		patch := PatchChange{
+			Id:     ref.Original.GetId(),
+			Fields: map[string]FieldChange{},
+		}
+		for field, value := range diff {
+			patch.Fields[field] = FieldChange{Old: original[field], New: value}
+		}
+		c := changes.collection(ref.Original.CollectionName())
+		c.Patches = append(c.Patches, patch)
+	}
+	for _, obj := range h.pendingDeletes {
+		c := changes.collection(obj.CollectionName())
+		c.Deletes = append(c.Deletes, obj.GetId())
+	}
+	// Tracking map has no order, keep output stable
+	for _, c := range changes {
+		sort.Slice(c.Patches, func(i, j int) bool {
+			return c.Patches[i].Id < c.Patches[j].Id
+		})
+	}
+	return changes
+}
@@ -2,17 +2,23 @@ package directus
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
 )
 
 type IDirectusCollectionAccessor interface {
-	patch(object map[string]any, id string) error
+	patch(ctx context.Context, object map[string]any, id string) error
+	load(ctx context.Context, id string) (IDirectusObject, error)
+	create(ctx context.Context, object map[string]any) (IDirectusObject, error)
+	delete(ctx context.Context, id string) error
 }
 
 type DirectusCollectionAccessor[K string | uuid.UUID | int, V IDirectusObject] struct {
@@ -21,9 +27,33 @@ type DirectusCollectionAccessor[K string | uuid.UUID | int, V IDirectusObject] s
 	collectionName string
 }
 
-func (h *DirectusCollectionAccessor[K, V]) LoadById(id K, accessContext *DirectusAccessContext) (*V, error) {
+func (h *DirectusCollectionAccessor[K, V]) LoadById(id K, accessContext *DirectusAccessContext) (_ *V, err error) {
+	op := h.api.startOperation(accessContext.context(), h.collectionName, "LoadById")
+	defer func() { op.end(err) }()
+
+	obj, err := h.fetchById(op, key2String(id))
+	if err != nil {
+		return nil, err
+	}
+	runAfterLoad(any(obj).(IDirectusObject))
+	accessContext.add2Track(obj)
+	return obj, nil
+}
+
+func (h *DirectusCollectionAccessor[K, V]) load(ctx context.Context, id string) (_ IDirectusObject, err error) {
+	op := h.api.startOperation(ctx, h.collectionName, "load")
+	defer func() { op.end(err) }()
+
+	obj, err := h.fetchById(op, id)
+	if err != nil {
+		return nil, err
+	}
+	return any(obj).(IDirectusObject), nil
+}
+
+func (h *DirectusCollectionAccessor[K, V]) fetchById(op *directusOperation, id string) (*V, error) {
 	addr := *h.api.directusUrl
-	addr.Path = path.Join(addr.Path, fmt.Sprintf("/items/%s/%s", h.collectionName, key2String(id)))
+	addr.Path = path.Join(addr.Path, fmt.Sprintf("/items/%s/%s", h.collectionName, id))
 	req, err := http.NewRequest("GET", addr.String(), nil)
 	if err != nil {
 		return nil, err
@@ -31,8 +61,7 @@ func (h *DirectusCollectionAccessor[K, V]) LoadById(id K, accessContext *Directu
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.api.token))
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := op.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -43,14 +72,13 @@ func (h *DirectusCollectionAccessor[K, V]) LoadById(id K, accessContext *Directu
 	if err != nil {
 		return nil, err
 	}
-	if item.Errors != nil {
-		msg := ""
-		if len(item.Errors) != 0 {
-			msg = item.Errors[0].Message
-		}
-		return nil, fmt.Errorf(msg)
+	err = item.err()
+	if err != nil {
+		return nil, err
+	}
+	if item.Data == nil {
+		return nil, fmt.Errorf("item %s of collection %s not found", id, h.collectionName)
 	}
-	accessContext.add2Track(item.Data)
 	return item.Data, nil
 }
 
@@ -63,6 +91,22 @@ type CollectionQuery[K string | uuid.UUID | int, V IDirectusObject] struct {
 
 	whereFilters   []string
 	fieldSelectors []string
+
+	aggregates map[AggregateFunction][]string
+	groupBy    []string
+
+	limit  *int
+	offset *int
+
+	sortFields []string
+	search     *string
+
+	deepQueries map[string]*DeepQuery
+	aliases     map[string]string
+
+	noTracking bool
+
+	ctx context.Context
 }
 
 func (h *DirectusCollectionAccessor[K, V]) ReadAll() *CollectionQuery[K, V] {
@@ -87,75 +131,155 @@ func (h *CollectionQuery[K, V]) Include(selector ...string) *CollectionQuery[K,
 	return h
 }
 
+// Sort orders results by fields, prefix field with "-" for descending order
+func (h *CollectionQuery[K, V]) Sort(field ...string) *CollectionQuery[K, V] {
+	for _, s := range field {
+		s = strings.ReplaceAll(s, " ", "")
+		h.sortFields = append(h.sortFields, strings.Split(s, ",")...)
+	}
+	return h
+}
+
+// Search filters items containing term in any of their string fields
+func (h *CollectionQuery[K, V]) Search(term string) *CollectionQuery[K, V] {
+	h.search = &term
+	return h
+}
+
+func (h *CollectionQuery[K, V]) Limit(limit int) *CollectionQuery[K, V] {
+	h.limit = &limit
+	return h
+}
+func (h *CollectionQuery[K, V]) Offset(offset int) *CollectionQuery[K, V] {
+	h.offset = &offset
+	return h
+}
+
+// Page sets limit and offset for 1-based page number
+func (h *CollectionQuery[K, V]) Page(page, pageSize int) *CollectionQuery[K, V] {
+	return h.Limit(pageSize).Offset((page - 1) * pageSize)
+}
+
+// AsNoTracking returns detached objects, they are not added to access context
+func (h *CollectionQuery[K, V]) AsNoTracking() *CollectionQuery[K, V] {
+	h.noTracking = true
+	return h
+}
+
+func (h *CollectionQuery[K, V]) track(accessContext *DirectusAccessContext, obj *V) {
+	runAfterLoad(any(obj).(IDirectusObject))
+	if h.noTracking {
+		return
+	}
+	accessContext.add2Track(obj)
+}
+
 // Service
+// WithContext runs requests of query with ctx, they are cancelled with it and their spans become children of spans carried by it
+func (h *CollectionQuery[K, V]) WithContext(ctx context.Context) *CollectionQuery[K, V] {
+	h.ctx = ctx
+	return h
+}
+
+func (h *CollectionQuery[K, V]) context() context.Context {
+	if h.ctx == nil {
+		return context.Background()
+	}
+	return h.ctx
+}
+
 func (h *CollectionQuery[K, V]) WithCustomHeader(key, value string) *CollectionQuery[K, V] {
 	h.customHeaders[key] = value
 	return h
 }
-func (h *CollectionQuery[K, V]) ToSlice(accessContext *DirectusAccessContext) ([]*V, error) {
-	addr := *h.Collection.api.directusUrl
-	addr.Path = path.Join(addr.Path, fmt.Sprintf("/items/%s", h.Collection.collectionName))
-	q := addr.Query()
+func (h *CollectionQuery[K, V]) ToSlice(accessContext *DirectusAccessContext) (_ []*V, err error) {
+	op := h.Collection.api.startOperation(h.context(), h.Collection.collectionName, "ToSlice")
+	defer func() { op.end(err) }()
 
-	filter, err := h.buildWhereFilters()
+	q, err := h.buildQuery(op)
 	if err != nil {
 		return nil, err
 	}
-
-	q.Add("filter", filter)
-	q.Add("fields", h.buildSelectors())
-	addr.RawQuery = q.Encode()
-	url := addr.String()
-	req, err := http.NewRequest("GET", url, nil)
+	item := DirectusResponse[[]*V]{}
+	err = h.fetch(op, q, &item)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.Collection.api.token))
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+	op.span.SetAttribute(ATTR_ITEM_COUNT, len(item.Data))
+	for _, e := range item.Data {
+		h.track(accessContext, e)
 	}
-	defer resp.Body.Close()
+	return item.Data, nil
+}
+func (h *CollectionQuery[K, V]) First(accessContext *DirectusAccessContext) (_ *V, _ bool, err error) {
+	op := h.Collection.api.startOperation(h.context(), h.Collection.collectionName, "First")
+	defer func() { op.end(err) }()
 
+	q, err := h.buildQuery(op)
+	if err != nil {
+		return nil, false, err
+	}
+	q.Set("limit", "1")
 	item := DirectusResponse[[]*V]{}
-	err = json.NewDecoder(resp.Body).Decode(&item)
+	err = h.fetch(op, q, &item)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	if item.Errors != nil {
-		msg := ""
-		if len(item.Errors) != 0 {
-			msg = item.Errors[0].Message
-		}
-		return nil, fmt.Errorf(msg)
+	op.span.SetAttribute(ATTR_ITEM_COUNT, len(item.Data))
+	if len(item.Data) == 0 {
+		return nil, false, nil
 	}
 
-	for _, e := range item.Data {
-		accessContext.add2Track(e)
-	}
-	return item.Data, nil
+	obj := item.Data[0]
+	h.track(accessContext, obj)
+	return obj, true, nil
 }
-func (h *CollectionQuery[K, V]) First(accessContext *DirectusAccessContext) (*V, bool, error) {
-	addr := *h.Collection.api.directusUrl
-	addr.Path = path.Join(addr.Path, fmt.Sprintf("/items/%s", h.Collection.collectionName))
-	q := addr.Query()
+
+// Builds query parameters shared by all reading operations
+func (h *CollectionQuery[K, V]) buildQuery(op *directusOperation) (url.Values, error) {
+	q := url.Values{}
 
 	filter, err := h.buildWhereFilters()
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
-
+	op.span.SetAttribute(ATTR_FILTER_SIZE, len(h.whereFilters))
 	q.Add("filter", filter)
 	q.Add("fields", h.buildSelectors())
-	q.Add("limit", "1")
+	for alias, field := range h.aliases {
+		q.Add(fmt.Sprintf("alias[%s]", alias), field)
+	}
+	if len(h.deepQueries) != 0 {
+		deep, err := h.buildDeep()
+		if err != nil {
+			return nil, err
+		}
+		q.Add("deep", deep)
+	}
+	if len(h.sortFields) != 0 {
+		q.Add("sort", strings.Join(h.sortFields, ","))
+	}
+	if h.search != nil {
+		q.Add("search", *h.search)
+	}
+	if h.limit != nil {
+		q.Add("limit", strconv.Itoa(*h.limit))
+	}
+	if h.offset != nil {
+		q.Add("offset", strconv.Itoa(*h.offset))
+	}
+	return q, nil
+}
+
+// Sends GET request to collection items endpoint and decodes response into item
+func (h *CollectionQuery[K, V]) fetch(op *directusOperation, q url.Values, item directusErrorsHolder) error {
+	addr := *h.Collection.api.directusUrl
+	addr.Path = path.Join(addr.Path, fmt.Sprintf("/items/%s", h.Collection.collectionName))
 	addr.RawQuery = q.Encode()
-	url := addr.String()
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequest("GET", addr.String(), nil)
 	if err != nil {
-		return nil, false, err
+		return err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.Collection.api.token))
@@ -163,36 +287,23 @@ func (h *CollectionQuery[K, V]) First(accessContext *DirectusAccessContext) (*V,
 		req.Header.Set(k, v)
 	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := op.do(req)
 	if err != nil {
-		return nil, false, err
+		return err
 	}
 	defer resp.Body.Close()
 
-	item := DirectusResponse[[]*V]{}
-
-	err = json.NewDecoder(resp.Body).Decode(&item)
+	err = json.NewDecoder(resp.Body).Decode(item)
 	if err != nil {
-		return nil, false, err
-	}
-	if item.Errors != nil {
-		msg := ""
-		if len(item.Errors) != 0 {
-			msg = item.Errors[0].Message
-		}
-		return nil, false, fmt.Errorf(msg)
-	}
-	if len(item.Data) == 0 {
-		return nil, false, nil
+		return err
 	}
-
-	obj := item.Data[0]
-	accessContext.add2Track(obj)
-	return obj, true, nil
+	return item.err()
 }
 
-func (h *DirectusCollectionAccessor[K, V]) patch(object map[string]any, id string) error {
+func (h *DirectusCollectionAccessor[K, V]) patch(ctx context.Context, object map[string]any, id string) (err error) {
+	op := h.api.startOperation(ctx, h.collectionName, "patch")
+	defer func() { op.end(err) }()
+
 	addr := *h.api.directusUrl
 	addr.Path = path.Join(addr.Path, fmt.Sprintf("/items/%s/%s", h.collectionName, id))
 
@@ -207,8 +318,7 @@ func (h *DirectusCollectionAccessor[K, V]) patch(object map[string]any, id strin
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.api.token))
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := op.do(req)
 	if err != nil {
 		return err
 	}
@@ -228,3 +338,69 @@ func (h *DirectusCollectionAccessor[K, V]) patch(object map[string]any, id strin
 	}
 	return nil
 }
+
+func (h *DirectusCollectionAccessor[K, V]) create(ctx context.Context, object map[string]any) (_ IDirectusObject, err error) {
+	op := h.api.startOperation(ctx, h.collectionName, "create")
+	defer func() { op.end(err) }()
+
+	addr := *h.api.directusUrl
+	addr.Path = path.Join(addr.Path, fmt.Sprintf("/items/%s", h.collectionName))
+
+	body, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", addr.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.api.token))
+
+	resp, err := op.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	item := DirectusResponse[*V]{}
+	err = json.NewDecoder(resp.Body).Decode(&item)
+	if err != nil {
+		return nil, err
+	}
+	err = item.err()
+	if err != nil {
+		return nil, err
+	}
+	return any(item.Data).(IDirectusObject), nil
+}
+
+func (h *DirectusCollectionAccessor[K, V]) delete(ctx context.Context, id string) (err error) {
+	op := h.api.startOperation(ctx, h.collectionName, "delete")
+	defer func() { op.end(err) }()
+
+	addr := *h.api.directusUrl
+	addr.Path = path.Join(addr.Path, fmt.Sprintf("/items/%s/%s", h.collectionName, id))
+	req, err := http.NewRequest("DELETE", addr.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.api.token))
+
+	resp, err := op.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Successful delete has no content
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	item := DirectusResponse[any]{}
+	err = json.NewDecoder(resp.Body).Decode(&item)
+	if err != nil {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return item.err()
+}
@@ -0,0 +1,133 @@
+package directus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type ConcurrencyStrategy int
+
+const (
+	// Fail SaveChanges with ConcurrencyConflictError
+	CONCURRENCY_FAIL = ConcurrencyStrategy(iota)
+	// Send local changes anyway, overwriting server values
+	CONCURRENCY_CLIENT_WINS
+	// Send only local changes of fields not changed on the server
+	CONCURRENCY_SERVER_WINS
+	// Let ConcurrencyOptions.Merge decide what to send
+	CONCURRENCY_MERGE
+)
+
+// Returns patch to send instead of local changes, nil patch skips the object
+type ConcurrencyMergeCallback func(conflict *ConcurrencyConflictError, local map[string]any) (map[string]any, error)
+
+type ConcurrencyOptions struct {
+	// Field changed by server on every update, e.g. date_updated
+	VersionField string
+	Strategy     ConcurrencyStrategy
+	Merge        ConcurrencyMergeCallback
+}
+
+// Object was changed on the server since it was loaded
+type ConcurrencyConflictError struct {
+	Collection string
+	Id         string
+	Original   IDirectusObject
+	Server     IDirectusObject
+	// Fields changed both locally and on the server, may be empty if changes do not overlap
+	Conflicts []FieldConflict
+}
+
+func (h *ConcurrencyConflictError) Error() string {
+	fields := make([]string, len(h.Conflicts))
+	for i, c := range h.Conflicts {
+		fields[i] = c.Field
+	}
+	return fmt.Sprintf("object %s[%s] was modified on the server, conflicting fields: [%s]", h.Collection, h.Id, strings.Join(fields, ","))
+}
+
+// UseOptimisticConcurrency makes SaveChanges verify version field of every changed object before patching it.
+// Objects without version field, or with version field null or not fetched (e.g. excluded by Include), are saved as usual,
+// so prefer a version field set on create as well.
+// Version is read with a separate request right before the patch, changes made on the server in between are not detected
+func (h *DirectusAccessContext) UseOptimisticConcurrency(options ConcurrencyOptions) error {
+	if options.VersionField == "" {
+		return fmt.Errorf("version field is required")
+	}
+	if options.Strategy == CONCURRENCY_MERGE && options.Merge == nil {
+		return fmt.Errorf("merge callback is required for merge strategy")
+	}
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	h.concurrency = &options
+	return nil
+}
+
+// Returns patch that should be sent for object according to concurrency options
+func (h *DirectusAccessContext) resolveConcurrency(ctx context.Context, ref trackingRef, diff map[string]any) (map[string]any, error) {
+	if h.concurrency == nil {
+		return diff, nil
+	}
+	originalVersion, versioned := ref.Original.Map()[h.concurrency.VersionField]
+	// Null or zero version cannot tell whether field was not fetched or object was never updated
+	if !versioned || deref(originalVersion) == nil || reflect.ValueOf(deref(originalVersion)).IsZero() {
+		return diff, nil
+	}
+	server, err := ref.OwnerCollection.load(ctx, ref.Original.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if valuesEqual(originalVersion, server.Map()[h.concurrency.VersionField]) {
+		return diff, nil
+	}
+
+	conflict := &ConcurrencyConflictError{
+		Collection: ref.Original.CollectionName(),
+		Id:         ref.Original.GetId(),
+		Original:   ref.Original,
+		Server:     server,
+		Conflicts:  findConflicts(ref.Original, ref.Actual, server),
+	}
+	switch h.concurrency.Strategy {
+	case CONCURRENCY_CLIENT_WINS:
+		return diff, nil
+	case CONCURRENCY_SERVER_WINS:
+		for _, c := range conflict.Conflicts {
+			delete(diff, c.Field)
+		}
+		if len(diff) == 0 {
+			return nil, nil
+		}
+		return diff, nil
+	case CONCURRENCY_MERGE:
+		return h.concurrency.Merge(conflict, diff)
+	default:
+		return nil, conflict
+	}
+}
+
+// Compares field values, dereferencing pointers and using Equal method when available
+func valuesEqual(a, b any) bool {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	for av.Kind() == reflect.Pointer && !av.IsNil() {
+		av = av.Elem()
+	}
+	for bv.Kind() == reflect.Pointer && !bv.IsNil() {
+		bv = bv.Elem()
+	}
+	aNil := !av.IsValid() || av.Kind() == reflect.Pointer
+	bNil := !bv.IsValid() || bv.Kind() == reflect.Pointer
+	if aNil || bNil {
+		return aNil && bNil
+	}
+	// Types like time.Time and JSON know better how to compare themselves
+	if equal := av.MethodByName("Equal"); equal.IsValid() && av.Type() == bv.Type() {
+		t := equal.Type()
+		if t.NumIn() == 1 && t.In(0) == bv.Type() && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Bool {
+			return equal.Call([]reflect.Value{bv})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(av.Interface(), bv.Interface())
+}
@@ -0,0 +1,225 @@
+package directus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Time types for directus date, time and dateTime fields, which have no timezone.
+// Timestamp fields keep using time.Time.
+// String returns the format directus expects, so values may be used in Where literals as is
+
+const (
+	DATE_LAYOUT           = "2006-01-02"
+	TIME_OF_DAY_LAYOUT    = "15:04:05.999999999"
+	LOCAL_DATETIME_LAYOUT = DATE_LAYOUT + "T" + TIME_OF_DAY_LAYOUT
+)
+
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+func ParseDate(s string) (Date, error) {
+	// Some databases return midnight timestamp for date fields
+	s, _, _ = strings.Cut(s, "T")
+	t, err := time.Parse(DATE_LAYOUT, s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// In returns midnight of date in location
+func (h Date) In(loc *time.Location) time.Time {
+	return time.Date(h.Year, h.Month, h.Day, 0, 0, 0, 0, loc)
+}
+
+func (h Date) AddDays(days int) Date {
+	return DateOf(h.In(time.UTC).AddDate(0, 0, days))
+}
+
+func (h Date) Before(other Date) bool {
+	return h.In(time.UTC).Before(other.In(time.UTC))
+}
+
+func (h Date) After(other Date) bool {
+	return h.In(time.UTC).After(other.In(time.UTC))
+}
+
+func (h Date) Equal(other Date) bool {
+	return h == other
+}
+
+func (h Date) IsZero() bool {
+	return h == Date{}
+}
+
+func (h Date) String() string {
+	return h.In(time.UTC).Format(DATE_LAYOUT)
+}
+
+// Zero date is not a valid date, it is sent as null
+func (h Date) MarshalJSON() ([]byte, error) {
+	if h.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(h.String())
+}
+
+func (h *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalTimeString(data, func(s string) error {
+		d, err := ParseDate(s)
+		*h = d
+		return err
+	})
+}
+
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+func NewTimeOfDay(hour, minute, second int) TimeOfDay {
+	return TimeOfDay{Hour: hour, Minute: minute, Second: second}
+}
+
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	layout := TIME_OF_DAY_LAYOUT
+	if strings.Count(s, ":") == 1 {
+		layout = "15:04"
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDayOf(t), nil
+}
+
+// On returns time of day at date in location
+func (h TimeOfDay) On(date Date, loc *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, h.Hour, h.Minute, h.Second, h.Nanosecond, loc)
+}
+
+func (h TimeOfDay) Before(other TimeOfDay) bool {
+	return h.On(Date{}, time.UTC).Before(other.On(Date{}, time.UTC))
+}
+
+func (h TimeOfDay) After(other TimeOfDay) bool {
+	return h.On(Date{}, time.UTC).After(other.On(Date{}, time.UTC))
+}
+
+func (h TimeOfDay) Equal(other TimeOfDay) bool {
+	return h == other
+}
+
+func (h TimeOfDay) String() string {
+	return h.On(Date{}, time.UTC).Format(TIME_OF_DAY_LAYOUT)
+}
+
+func (h TimeOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+func (h *TimeOfDay) UnmarshalJSON(data []byte) error {
+	return unmarshalTimeString(data, func(s string) error {
+		t, err := ParseTimeOfDay(s)
+		*h = t
+		return err
+	})
+}
+
+type LocalDateTime struct {
+	Date
+	TimeOfDay
+}
+
+func LocalDateTimeOf(t time.Time) LocalDateTime {
+	return LocalDateTime{Date: DateOf(t), TimeOfDay: TimeOfDayOf(t)}
+}
+
+func ParseLocalDateTime(s string) (LocalDateTime, error) {
+	// Offset, if any, is dropped together with the timezone
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return LocalDateTimeOf(t), nil
+	}
+	t, err := time.Parse(LOCAL_DATETIME_LAYOUT, strings.Replace(s, " ", "T", 1))
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTimeOf(t), nil
+}
+
+// In returns the moment of local date and time in location
+func (h LocalDateTime) In(loc *time.Location) time.Time {
+	return h.TimeOfDay.On(h.Date, loc)
+}
+
+func (h LocalDateTime) Before(other LocalDateTime) bool {
+	return h.In(time.UTC).Before(other.In(time.UTC))
+}
+
+func (h LocalDateTime) After(other LocalDateTime) bool {
+	return h.In(time.UTC).After(other.In(time.UTC))
+}
+
+func (h LocalDateTime) Equal(other LocalDateTime) bool {
+	return h == other
+}
+
+func (h LocalDateTime) IsZero() bool {
+	return h == LocalDateTime{}
+}
+
+func (h LocalDateTime) String() string {
+	return h.In(time.UTC).Format(LOCAL_DATETIME_LAYOUT)
+}
+
+// Zero value is sent as null like zero Date
+func (h LocalDateTime) MarshalJSON() ([]byte, error) {
+	if h.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(h.String())
+}
+
+func (h *LocalDateTime) UnmarshalJSON(data []byte) error {
+	return unmarshalTimeString(data, func(s string) error {
+		t, err := ParseLocalDateTime(s)
+		*h = t
+		return err
+	})
+}
+
+// Null leaves value unchanged
+func unmarshalTimeString(data []byte, parse func(s string) error) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return fmt.Errorf("time value must be a string: %w", err)
+	}
+	return parse(s)
+}
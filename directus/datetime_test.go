@@ -0,0 +1,34 @@
+package directus
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSlotDecodesLocalDateTime(t *testing.T) {
+	var slot Slot
+	data := `{"id":"2f1b6a4e-6a8e-4b8a-9d0c-3f5a1c2e7b90","expires_at":"2026-03-01T12:30:00"}`
+	if err := json.Unmarshal([]byte(data), &slot); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	want := LocalDateTime{Date: NewDate(2026, time.March, 1), TimeOfDay: NewTimeOfDay(12, 30, 0)}
+	if !slot.ExpiresAt.Equal(want) {
+		t.Errorf("expires_at = %s, want %s", slot.ExpiresAt, want)
+	}
+	out, _ := json.Marshal(slot.Map()["expires_at"])
+	if string(out) != `"2026-03-01T12:30:00"` {
+		t.Errorf("marshal = %s", out)
+	}
+}
+
+func TestParseDateAndTimeOfDay(t *testing.T) {
+	d, err := ParseDate("2026-03-01T00:00:00")
+	if err != nil || d != NewDate(2026, time.March, 1) {
+		t.Errorf("ParseDate = %v, %v", d, err)
+	}
+	tod, err := ParseTimeOfDay("08:15")
+	if err != nil || tod != NewTimeOfDay(8, 15, 0) {
+		t.Errorf("ParseTimeOfDay = %v, %v", tod, err)
+	}
+}
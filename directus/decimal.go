@@ -0,0 +1,188 @@
+package directus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Exact decimal number for directus decimal fields, immutable.
+// Zero value is 0
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+var bigTen = big.NewInt(10)
+
+// NewDecimal returns unscaled * 10^-scale, e.g. NewDecimal(1999, 2) is 19.99
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	if scale < 0 {
+		return Decimal{unscaled: new(big.Int).Mul(big.NewInt(unscaled), pow10(-scale))}
+	}
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(s), "e")
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok || strings.ContainsAny(fracPart, "+-") {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+	}
+	d := Decimal{unscaled: unscaled, scale: int32(len(fracPart))}
+	if hasExponent {
+		var exp int32
+		_, err := fmt.Sscan(exponent, &exp)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+		}
+		// Exponent only moves decimal point
+		d.scale -= exp
+		if d.scale < 0 {
+			d = Decimal{unscaled: new(big.Int).Mul(d.unscaled, pow10(-d.scale))}
+		}
+	}
+	return d, nil
+}
+
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (h Decimal) int() *big.Int {
+	if h.unscaled == nil {
+		return new(big.Int)
+	}
+	return h.unscaled
+}
+
+// Changes non-negative scale, increasing scale is exact, decreasing it truncates
+func (h Decimal) rescale(scale int32) Decimal {
+	switch {
+	case scale == h.scale:
+		return h
+	case scale > h.scale:
+		return Decimal{unscaled: new(big.Int).Mul(h.int(), pow10(scale-h.scale)), scale: scale}
+	default:
+		return Decimal{unscaled: new(big.Int).Quo(h.int(), pow10(h.scale-scale)), scale: scale}
+	}
+}
+
+func alignDecimals(a, b Decimal) (Decimal, Decimal) {
+	if a.scale > b.scale {
+		return a, b.rescale(a.scale)
+	}
+	return a.rescale(b.scale), b
+}
+
+func (h Decimal) Add(other Decimal) Decimal {
+	a, b := alignDecimals(h, other)
+	return Decimal{unscaled: new(big.Int).Add(a.int(), b.int()), scale: a.scale}
+}
+
+func (h Decimal) Sub(other Decimal) Decimal {
+	a, b := alignDecimals(h, other)
+	return Decimal{unscaled: new(big.Int).Sub(a.int(), b.int()), scale: a.scale}
+}
+
+func (h Decimal) Mul(other Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(h.int(), other.int()), scale: h.scale + other.scale}
+}
+
+// Div divides with result rounded half away from zero to scale digits after point
+func (h Decimal) Div(other Decimal, scale int32) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, fmt.Errorf("decimal division by zero")
+	}
+	num := new(big.Rat).SetFrac(h.int(), pow10(h.scale))
+	den := new(big.Rat).SetFrac(other.int(), pow10(other.scale))
+	return ParseDecimal(new(big.Rat).Quo(num, den).FloatString(int(scale)))
+}
+
+func (h Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(h.int()), scale: h.scale}
+}
+
+// Round rounds half away from zero to places digits after point,
+// negative places round to tens, hundreds, ... e.g. 123.45 to -1 is 120
+func (h Decimal) Round(places int32) Decimal {
+	if places >= h.scale {
+		return h
+	}
+	if places < 0 {
+		unit := pow10(-places)
+		r := new(big.Rat).SetFrac(h.int(), new(big.Int).Mul(pow10(h.scale), unit))
+		n, _ := new(big.Int).SetString(r.FloatString(0), 10)
+		return Decimal{unscaled: n.Mul(n, unit)}
+	}
+	r := new(big.Rat).SetFrac(h.int(), pow10(h.scale))
+	d, _ := ParseDecimal(r.FloatString(int(places)))
+	return d
+}
+
+func (h Decimal) Cmp(other Decimal) int {
+	a, b := alignDecimals(h, other)
+	return a.int().Cmp(b.int())
+}
+
+// Equal compares values, 1.50 equals 1.5
+func (h Decimal) Equal(other Decimal) bool {
+	return h.Cmp(other) == 0
+}
+
+func (h Decimal) IsZero() bool {
+	return h.int().Sign() == 0
+}
+
+func (h Decimal) Sign() int {
+	return h.int().Sign()
+}
+
+func (h Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(h.int(), pow10(h.scale)).Float64()
+	return f
+}
+
+func (h Decimal) String() string {
+	s := new(big.Int).Abs(h.int()).String()
+	if h.scale > 0 {
+		if len(s) <= int(h.scale) {
+			s = strings.Repeat("0", int(h.scale)-len(s)+1) + s
+		}
+		s = s[:len(s)-int(h.scale)] + "." + s[len(s)-int(h.scale):]
+	}
+	if h.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Directus sends decimals as strings to keep precision, they are sent back the same way
+func (h Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+func (h *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(bytes.TrimSpace(data), `"`)
+	if string(data) == "null" {
+		return nil
+	}
+	d, err := ParseDecimal(string(data))
+	if err != nil {
+		return err
+	}
+	*h = d
+	return nil
+}
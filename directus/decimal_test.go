@@ -0,0 +1,145 @@
+package directus
+
+import "testing"
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"0", "0"},
+		{"19.99", "19.99"},
+		{"-.5", "-0.5"},
+		{".05", "0.05"},
+		{"1.50", "1.50"},
+		{"1.5e-3", "0.0015"},
+		{"1.5E3", "1500"},
+		{"-2e2", "-200"},
+		{"12.345e1", "123.45"},
+		{" 7 ", "7"},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) error: %v", tt.in, err)
+			continue
+		}
+		if d.String() != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, d, tt.want)
+		}
+	}
+}
+
+func TestParseDecimalInvalid(t *testing.T) {
+	for _, in := range []string{"", "abc", "1.2.3", "1.-5", "1e", "1ex"} {
+		if _, err := ParseDecimal(in); err == nil {
+			t.Errorf("ParseDecimal(%q) expected error", in)
+		}
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int32
+		want   string
+	}{
+		{"1.25", 1, "1.3"},
+		{"-1.25", 1, "-1.3"},
+		{"1.24", 1, "1.2"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"1.2", 2, "1.2"},
+		{"123.45", -1, "120"},
+		{"125", -1, "130"},
+		{"-125", -1, "-130"},
+		{"149.99", -2, "100"},
+		{"42", -3, "0"},
+	}
+	for _, tt := range tests {
+		got := MustParseDecimal(tt.in).Round(tt.places)
+		if got.String() != tt.want {
+			t.Errorf("Round(%s, %d) = %s, want %s", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalDiv(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		scale int32
+		want  string
+	}{
+		{"10", "3", 2, "3.33"},
+		{"2", "3", 2, "0.67"},
+		{"-2", "3", 2, "-0.67"},
+		{"1", "8", 2, "0.13"},
+		{"1.5", "0.5", 0, "3"},
+	}
+	for _, tt := range tests {
+		got, err := MustParseDecimal(tt.a).Div(MustParseDecimal(tt.b), tt.scale)
+		if err != nil {
+			t.Errorf("%s / %s error: %v", tt.a, tt.b, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("%s / %s = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+	}
+	if _, err := MustParseDecimal("1").Div(Decimal{}, 2); err == nil {
+		t.Error("division by zero expected error")
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, b := MustParseDecimal("19.99"), MustParseDecimal("0.011")
+	if got := a.Add(b).String(); got != "20.001" {
+		t.Errorf("Add = %s", got)
+	}
+	if got := a.Sub(b).String(); got != "19.979" {
+		t.Errorf("Sub = %s", got)
+	}
+	if got := a.Mul(MustParseDecimal("3")).String(); got != "59.97" {
+		t.Errorf("Mul = %s", got)
+	}
+	if got := NewDecimal(5, -2).String(); got != "500" {
+		t.Errorf("NewDecimal(5, -2) = %s", got)
+	}
+	if got := (Decimal{}).Add(a).String(); got != "19.99" {
+		t.Errorf("zero Add = %s", got)
+	}
+}
+
+func TestDecimalCmp(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.5", "1.50", 0},
+		{"1.5", "1.499", 1},
+		{"-0.1", "0", -1},
+		{"100", "1e2", 0},
+		{"0.0015", "1.5e-3", 0},
+	}
+	for _, tt := range tests {
+		if got := MustParseDecimal(tt.a).Cmp(MustParseDecimal(tt.b)); got != tt.want {
+			t.Errorf("Cmp(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+	if !MustParseDecimal("1.5").Equal(MustParseDecimal("1.500")) {
+		t.Error("1.5 should equal 1.500")
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var d Decimal
+	for _, in := range []string{`"12.30"`, `12.30`} {
+		if err := d.UnmarshalJSON([]byte(in)); err != nil || d.String() != "12.30" {
+			t.Errorf("UnmarshalJSON(%s) = %s, %v", in, d, err)
+		}
+	}
+	data, err := d.MarshalJSON()
+	if err != nil || string(data) != `"12.30"` {
+		t.Errorf("MarshalJSON = %s, %v", data, err)
+	}
+}
@@ -0,0 +1,111 @@
+package directus
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Filtering, sorting and pagination of nested relational items
+type DeepQuery struct {
+	whereFilters []string
+	inFilters    map[string][]any
+	sortFields   []string
+	limit        *int
+	offset       *int
+}
+
+func NewDeepQuery() *DeepQuery {
+	return &DeepQuery{
+		whereFilters: []string{},
+		sortFields:   []string{},
+	}
+}
+
+// Where uses the same syntax as CollectionQuery.Where, fields are relative to the related collection
+func (h *DeepQuery) Where(comparator ...string) *DeepQuery {
+	h.whereFilters = append(h.whereFilters, comparator...)
+	return h
+}
+func (h *DeepQuery) whereIn(field string, values ...any) *DeepQuery {
+	if h.inFilters == nil {
+		h.inFilters = map[string][]any{}
+	}
+	h.inFilters[field] = append(h.inFilters[field], values...)
+	return h
+}
+func (h *DeepQuery) Sort(field ...string) *DeepQuery {
+	for _, s := range field {
+		s = strings.ReplaceAll(s, " ", "")
+		h.sortFields = append(h.sortFields, strings.Split(s, ",")...)
+	}
+	return h
+}
+func (h *DeepQuery) Limit(limit int) *DeepQuery {
+	h.limit = &limit
+	return h
+}
+func (h *DeepQuery) Offset(offset int) *DeepQuery {
+	h.offset = &offset
+	return h
+}
+
+func (h *DeepQuery) build() (map[string]any, error) {
+	params := map[string]any{}
+	if len(h.whereFilters) != 0 || len(h.inFilters) != 0 {
+		filter, err := buildFilterMap(h.whereFilters)
+		if err != nil {
+			return nil, err
+		}
+		for field, values := range h.inFilters {
+			filter[field] = map[FilterOperation]any{FILTER_IN: values}
+		}
+		params["_filter"] = filter
+	}
+	if len(h.sortFields) != 0 {
+		params["_sort"] = h.sortFields
+	}
+	if h.limit != nil {
+		params["_limit"] = *h.limit
+	}
+	if h.offset != nil {
+		params["_offset"] = *h.offset
+	}
+	return params, nil
+}
+
+// Deep applies query to nested items of relation, relation may be a dotted path like "panels.user_created".
+// Relation fields still have to be requested with Include
+func (h *CollectionQuery[K, V]) Deep(relation string, query *DeepQuery) *CollectionQuery[K, V] {
+	if h.deepQueries == nil {
+		h.deepQueries = map[string]*DeepQuery{}
+	}
+	h.deepQueries[strings.ReplaceAll(relation, " ", "")] = query
+	return h
+}
+
+func (h *CollectionQuery[K, V]) buildDeep() (string, error) {
+	deep := map[string]any{}
+	for relation, query := range h.deepQueries {
+		params, err := query.build()
+		if err != nil {
+			return "", err
+		}
+		node := deep
+		for _, p := range strings.Split(relation, ".") {
+			next, ok := node[p].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				node[p] = next
+			}
+			node = next
+		}
+		for k, v := range params {
+			node[k] = v
+		}
+	}
+	result, err := json.Marshal(deep)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
@@ -20,6 +20,12 @@ const (
 	FILTER_LESS_OR_EQUALS    = FilterOperation("_lte")
 	FILTER_GREATER_OR_EQUALS = FilterOperation("_gte")
 	FILTER_GREATER           = FilterOperation("_gt")
+	FILTER_IN                = FilterOperation("_in")
+
+	FILTER_INTERSECTS       = FilterOperation("_intersects")
+	FILTER_NINTERSECTS      = FilterOperation("_nintersects")
+	FILTER_INTERSECTS_BBOX  = FilterOperation("_intersects_bbox")
+	FILTER_NINTERSECTS_BBOX = FilterOperation("_nintersects_bbox")
 
 	FILTER_OR  = FilterOperation("_or")
 	FILTER_AND = FilterOperation("_and")
@@ -66,26 +72,79 @@ func getOperand(op ast.Expr) ([]string, token.Token, error) {
 	return nil, 0, fmt.Errorf("Failed to get operand from ast tree")
 }
 
+// Parses conditions built by Intersects and similar functions
+func getGeoCondition(call *ast.CallExpr) (FilterOperation, []string, json.RawMessage, error) {
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok || len(call.Args) != 2 {
+		return "", nil, nil, fmt.Errorf("Failed to get geo filter from ast tree")
+	}
+	op := FilterOperation(fun.Name)
+	switch op {
+	case FILTER_INTERSECTS, FILTER_NINTERSECTS, FILTER_INTERSECTS_BBOX, FILTER_NINTERSECTS_BBOX:
+	default:
+		return "", nil, nil, fmt.Errorf("Unsupported filter function %s", fun.Name)
+	}
+	field, _, err := getOperand(call.Args[0])
+	if err != nil {
+		return "", nil, nil, err
+	}
+	lit, ok := call.Args[1].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", nil, nil, fmt.Errorf("Geometry of %s must be a GeoJSON string", op)
+	}
+	geometry, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if !json.Valid([]byte(geometry)) {
+		return "", nil, nil, fmt.Errorf("Invalid GeoJSON in %s filter", op)
+	}
+	return op, field, json.RawMessage(geometry), nil
+}
+
 func (h *CollectionQuery[K, V]) buildWhereFilters() (string, error) {
+	fmap, err := buildFilterMap(h.whereFilters)
+	if err != nil {
+		return "", err
+	}
+	result, err := json.Marshal(fmap)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+func buildFilterMap(whereFilters []string) (map[string]any, error) {
 	fmap := make(map[string]any)
 
-	for _, filterString := range h.whereFilters {
+	for _, filterString := range whereFilters {
 
 		binaryExpr, err := parser.ParseExpr(filterString)
 		if err != nil {
-			return "", err
+			return nil, err
+		}
+		if call, ok := binaryExpr.(*ast.CallExpr); ok {
+			op, field, geometry, err := getGeoCondition(call)
+			if err != nil {
+				return nil, err
+			}
+			fmap[field[0]] = deepCreate(map[FilterOperation]any{op: geometry}, field)
+			continue
+		}
+		if _, ok := binaryExpr.(*ast.BinaryExpr); !ok {
+			return nil, fmt.Errorf("Unsupported filter expression: %s", filterString)
 		}
 		op, err := getOperator(binaryExpr.(*ast.BinaryExpr).Op)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		left, _, err := getOperand(binaryExpr.(*ast.BinaryExpr).X)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		right, rkind, err := getOperand(binaryExpr.(*ast.BinaryExpr).Y)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		switch rkind {
 		case token.STRING:
@@ -94,16 +153,12 @@ func (h *CollectionQuery[K, V]) buildWhereFilters() (string, error) {
 			v, _ := strconv.ParseInt(right[0], 10, 32)
 			fmap[left[0]] = deepCreate(map[FilterOperation]any{op: int32(v)}, left)
 		case token.FLOAT:
-			v, _ := strconv.ParseFloat(right[0], 32)
-			fmap[left[0]] = deepCreate(map[FilterOperation]any{op: float32(v)}, left)
+			// Literal is sent as is, so decimals are compared exactly
+			fmap[left[0]] = deepCreate(map[FilterOperation]any{op: json.Number(right[0])}, left)
 		}
 
 	}
-	result, err := json.Marshal(fmap)
-	if err != nil {
-		return "", err
-	}
-	return string(result), nil
+	return fmap, nil
 }
 
 func (h *CollectionQuery[K, V]) buildSelectors() string {
@@ -0,0 +1,289 @@
+package directus
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+type GeometryType string
+
+const (
+	GEOMETRY_POINT             = GeometryType("Point")
+	GEOMETRY_MULTI_POINT       = GeometryType("MultiPoint")
+	GEOMETRY_LINE_STRING       = GeometryType("LineString")
+	GEOMETRY_MULTI_LINE_STRING = GeometryType("MultiLineString")
+	GEOMETRY_POLYGON           = GeometryType("Polygon")
+	GEOMETRY_MULTI_POLYGON     = GeometryType("MultiPolygon")
+	GEOMETRY_COLLECTION        = GeometryType("GeometryCollection")
+)
+
+// GeoJSON geometry of directus geometry fields
+type IGeometry interface {
+	GeometryType() GeometryType
+}
+
+// Longitude, latitude and optional altitude
+type Position []float64
+
+type Point struct {
+	Coordinates Position
+}
+
+type MultiPoint struct {
+	Coordinates []Position
+}
+
+type LineString struct {
+	Coordinates []Position
+}
+
+type MultiLineString struct {
+	Coordinates [][]Position
+}
+
+// First ring is exterior, others are holes
+type Polygon struct {
+	Coordinates [][]Position
+}
+
+type MultiPolygon struct {
+	Coordinates [][][]Position
+}
+
+type GeometryCollection struct {
+	Geometries []Geometry
+}
+
+// Geometry of any type, for geometry fields without specified type
+type Geometry struct {
+	Value IGeometry
+}
+
+func NewPoint(longitude, latitude float64) Point {
+	return Point{Coordinates: Position{longitude, latitude}}
+}
+
+// NewBBox returns rectangle polygon, e.g. for FILTER_INTERSECTS_BBOX
+func NewBBox(minLongitude, minLatitude, maxLongitude, maxLatitude float64) Polygon {
+	return Polygon{Coordinates: [][]Position{{
+		{minLongitude, minLatitude},
+		{maxLongitude, minLatitude},
+		{maxLongitude, maxLatitude},
+		{minLongitude, maxLatitude},
+		{minLongitude, minLatitude},
+	}}}
+}
+
+func (h Point) Longitude() float64 {
+	return h.position(0)
+}
+
+func (h Point) Latitude() float64 {
+	return h.position(1)
+}
+
+func (h Point) position(i int) float64 {
+	if len(h.Coordinates) <= i {
+		return 0
+	}
+	return h.Coordinates[i]
+}
+
+func (h Point) GeometryType() GeometryType              { return GEOMETRY_POINT }
+func (h MultiPoint) GeometryType() GeometryType         { return GEOMETRY_MULTI_POINT }
+func (h LineString) GeometryType() GeometryType         { return GEOMETRY_LINE_STRING }
+func (h MultiLineString) GeometryType() GeometryType    { return GEOMETRY_MULTI_LINE_STRING }
+func (h Polygon) GeometryType() GeometryType            { return GEOMETRY_POLYGON }
+func (h MultiPolygon) GeometryType() GeometryType       { return GEOMETRY_MULTI_POLYGON }
+func (h GeometryCollection) GeometryType() GeometryType { return GEOMETRY_COLLECTION }
+
+func (h Geometry) GeometryType() GeometryType {
+	if h.Value == nil {
+		return ""
+	}
+	return h.Value.GeometryType()
+}
+
+func (h Point) Equal(other Point) bool                           { return reflect.DeepEqual(h, other) }
+func (h MultiPoint) Equal(other MultiPoint) bool                 { return reflect.DeepEqual(h, other) }
+func (h LineString) Equal(other LineString) bool                 { return reflect.DeepEqual(h, other) }
+func (h MultiLineString) Equal(other MultiLineString) bool       { return reflect.DeepEqual(h, other) }
+func (h Polygon) Equal(other Polygon) bool                       { return reflect.DeepEqual(h, other) }
+func (h MultiPolygon) Equal(other MultiPolygon) bool             { return reflect.DeepEqual(h, other) }
+func (h GeometryCollection) Equal(other GeometryCollection) bool { return reflect.DeepEqual(h, other) }
+func (h Geometry) Equal(other Geometry) bool                     { return reflect.DeepEqual(h, other) }
+
+func (h Geometry) DeepCopy() Geometry {
+	if h.Value == nil {
+		return h
+	}
+	return Geometry{Value: deepCopyValue(reflect.ValueOf(h.Value)).Interface().(IGeometry)}
+}
+
+type geoJSON struct {
+	Type        GeometryType    `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates,omitempty"`
+	Geometries  []Geometry      `json:"geometries,omitempty"`
+}
+
+func marshalGeometry(t GeometryType, coordinates any) ([]byte, error) {
+	data, err := json.Marshal(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(geoJSON{Type: t, Coordinates: data})
+}
+
+func unmarshalGeometry(data []byte, t GeometryType, coordinates any) error {
+	if string(data) == "null" {
+		return nil
+	}
+	g := geoJSON{}
+	err := json.Unmarshal(data, &g)
+	if err != nil {
+		return err
+	}
+	if g.Type != t {
+		return fmt.Errorf("expected %s geometry, got %q", t, g.Type)
+	}
+	if g.Coordinates == nil {
+		return nil
+	}
+	return json.Unmarshal(g.Coordinates, coordinates)
+}
+
+func (h Point) MarshalJSON() ([]byte, error) {
+	return marshalGeometry(GEOMETRY_POINT, h.Coordinates)
+}
+func (h *Point) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, GEOMETRY_POINT, &h.Coordinates)
+}
+func (h MultiPoint) MarshalJSON() ([]byte, error) {
+	return marshalGeometry(GEOMETRY_MULTI_POINT, h.Coordinates)
+}
+func (h *MultiPoint) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, GEOMETRY_MULTI_POINT, &h.Coordinates)
+}
+func (h LineString) MarshalJSON() ([]byte, error) {
+	return marshalGeometry(GEOMETRY_LINE_STRING, h.Coordinates)
+}
+func (h *LineString) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, GEOMETRY_LINE_STRING, &h.Coordinates)
+}
+func (h MultiLineString) MarshalJSON() ([]byte, error) {
+	return marshalGeometry(GEOMETRY_MULTI_LINE_STRING, h.Coordinates)
+}
+func (h *MultiLineString) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, GEOMETRY_MULTI_LINE_STRING, &h.Coordinates)
+}
+func (h Polygon) MarshalJSON() ([]byte, error) {
+	return marshalGeometry(GEOMETRY_POLYGON, h.Coordinates)
+}
+func (h *Polygon) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, GEOMETRY_POLYGON, &h.Coordinates)
+}
+func (h MultiPolygon) MarshalJSON() ([]byte, error) {
+	return marshalGeometry(GEOMETRY_MULTI_POLYGON, h.Coordinates)
+}
+func (h *MultiPolygon) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, GEOMETRY_MULTI_POLYGON, &h.Coordinates)
+}
+
+func (h GeometryCollection) MarshalJSON() ([]byte, error) {
+	geometries := h.Geometries
+	if geometries == nil {
+		geometries = []Geometry{}
+	}
+	return json.Marshal(geoJSON{Type: GEOMETRY_COLLECTION, Geometries: geometries})
+}
+func (h *GeometryCollection) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	g := geoJSON{}
+	err := json.Unmarshal(data, &g)
+	if err != nil {
+		return err
+	}
+	if g.Type != GEOMETRY_COLLECTION {
+		return fmt.Errorf("expected %s geometry, got %q", GEOMETRY_COLLECTION, g.Type)
+	}
+	h.Geometries = g.Geometries
+	return nil
+}
+
+func (h Geometry) MarshalJSON() ([]byte, error) {
+	if h.Value == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(h.Value)
+}
+
+// Decodes into geometry type named by "type" member
+func (h *Geometry) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		h.Value = nil
+		return nil
+	}
+	g := struct {
+		Type GeometryType `json:"type"`
+	}{}
+	err := json.Unmarshal(data, &g)
+	if err != nil {
+		return err
+	}
+	var value IGeometry
+	switch g.Type {
+	case GEOMETRY_POINT:
+		value = &Point{}
+	case GEOMETRY_MULTI_POINT:
+		value = &MultiPoint{}
+	case GEOMETRY_LINE_STRING:
+		value = &LineString{}
+	case GEOMETRY_MULTI_LINE_STRING:
+		value = &MultiLineString{}
+	case GEOMETRY_POLYGON:
+		value = &Polygon{}
+	case GEOMETRY_MULTI_POLYGON:
+		value = &MultiPolygon{}
+	case GEOMETRY_COLLECTION:
+		value = &GeometryCollection{}
+	default:
+		return fmt.Errorf("unknown geometry type %q", g.Type)
+	}
+	err = json.Unmarshal(data, value)
+	if err != nil {
+		return err
+	}
+	// Values are kept as is, not pointers, so Equal compares geometries
+	h.Value = reflect.ValueOf(value).Elem().Interface().(IGeometry)
+	return nil
+}
+
+// Intersects returns Where condition matching items whose geometry field intersects geometry,
+// e.g. Where(Intersects("location", NewPoint(13.4, 52.5)))
+func Intersects(field string, geometry IGeometry) string {
+	return geoCondition(FILTER_INTERSECTS, field, geometry)
+}
+
+func NotIntersects(field string, geometry IGeometry) string {
+	return geoCondition(FILTER_NINTERSECTS, field, geometry)
+}
+
+// IntersectsBBox matches items whose geometry field intersects bounding box of geometry
+func IntersectsBBox(field string, geometry IGeometry) string {
+	return geoCondition(FILTER_INTERSECTS_BBOX, field, geometry)
+}
+
+func NotIntersectsBBox(field string, geometry IGeometry) string {
+	return geoCondition(FILTER_NINTERSECTS_BBOX, field, geometry)
+}
+
+// Geometry is passed as quoted GeoJSON argument, e.g. _intersects(location, "{\"type\":\"Point\",...}")
+func geoCondition(op FilterOperation, field string, geometry IGeometry) string {
+	// Failed marshaling leaves empty argument, which is reported when filter is built
+	data, _ := json.Marshal(geometry)
+	return fmt.Sprintf("%s(%s, %s)", op, field, strconv.Quote(string(data)))
+}
@@ -0,0 +1,161 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+)
+
+const (
+	HEALTH_OK    = "ok"
+	HEALTH_WARN  = "warn"
+	HEALTH_ERROR = "error"
+)
+
+type DirectusHealthCheck struct {
+	ComponentId   string  `json:"componentId"`
+	ComponentType string  `json:"componentType"`
+	ObservedValue any     `json:"observedValue"`
+	ObservedUnit  string  `json:"observedUnit"`
+	Threshold     float64 `json:"threshold"`
+	Status        string  `json:"status"`
+	Output        any     `json:"output"`
+}
+
+// Result of /server/health, Checks are only filled for admin tokens
+type DirectusHealth struct {
+	Status    string                           `json:"status"`
+	ReleaseId string                           `json:"releaseId"`
+	ServiceId string                           `json:"serviceId"`
+	Checks    map[string][]DirectusHealthCheck `json:"checks"`
+}
+
+func (h *DirectusHealth) Ready() bool {
+	return h.Status == HEALTH_OK || h.Status == HEALTH_WARN
+}
+
+func (h *DirectusApi) HealthCheck() (_ *DirectusHealth, err error) {
+	op := h.startOperation(context.Background(), "", "HealthCheck")
+	defer func() { op.end(err) }()
+
+	addr := *h.directusUrl
+	addr.Path = path.Join(addr.Path, "/server/health")
+
+	req, err := http.NewRequest("GET", addr.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.token))
+
+	resp, err := op.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Directus answers 503 with the same body when status is error
+	health := &DirectusHealth{}
+	err = json.NewDecoder(resp.Body).Decode(health)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected health response, status code: %d: %w", resp.StatusCode, err)
+	}
+	return health, nil
+}
+
+// Called on every readiness transition and on the first health check of the monitor, err is set when directus is unreachable
+type HealthChangedCallback func(ready bool, health *DirectusHealth, err error)
+
+type healthMonitor struct {
+	mutex sync.RWMutex
+	// False until first poll, which is always reported as transition
+	checked   bool
+	ready     bool
+	health    *DirectusHealth
+	lastErr   error
+	callbacks []HealthChangedCallback
+	stop      chan struct{}
+}
+
+// Starts polling /server/health in background, subsequent calls restart the monitor with new interval
+func (h *DirectusApi) StartHealthMonitor(interval time.Duration) {
+	h.StopHealthMonitor()
+	stop := make(chan struct{})
+	h.monitor.mutex.Lock()
+	h.monitor.stop = stop
+	h.monitor.mutex.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			h.pollHealth()
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (h *DirectusApi) StopHealthMonitor() {
+	h.monitor.mutex.Lock()
+	defer h.monitor.mutex.Unlock()
+	if h.monitor.stop != nil {
+		close(h.monitor.stop)
+		h.monitor.stop = nil
+	}
+}
+
+// Registers callback for readiness transitions, use WithHealthChangedCallback
+// to have it in place before monitor started by WithHealthMonitor polls the first time
+func (h *DirectusApi) OnHealthChanged(callback HealthChangedCallback) {
+	h.monitor.mutex.Lock()
+	defer h.monitor.mutex.Unlock()
+	h.monitor.callbacks = append(h.monitor.callbacks, callback)
+}
+
+// Readiness as seen by the last health check of the monitor
+func (h *DirectusApi) IsReady() bool {
+	h.monitor.mutex.RLock()
+	defer h.monitor.mutex.RUnlock()
+	return h.monitor.ready
+}
+
+func (h *DirectusApi) LastHealth() (*DirectusHealth, error) {
+	h.monitor.mutex.RLock()
+	defer h.monitor.mutex.RUnlock()
+	return h.monitor.health, h.monitor.lastErr
+}
+
+func (h *DirectusApi) pollHealth() {
+	health, err := h.HealthCheck()
+	ready := err == nil && health.Ready()
+
+	h.monitor.mutex.Lock()
+	changed := ready != h.monitor.ready || !h.monitor.checked
+	h.monitor.checked = true
+	h.monitor.ready = ready
+	h.monitor.health = health
+	h.monitor.lastErr = err
+	callbacks := h.monitor.callbacks
+	h.monitor.mutex.Unlock()
+
+	if !changed {
+		return
+	}
+	if ready {
+		h.infoLogger.Printf("Directus is ready\n")
+	} else if err != nil {
+		h.errLogger.Printf("Directus is not ready: %s\n", err.Error())
+	} else {
+		h.errLogger.Printf("Directus is not ready, health status: %s\n", health.Status)
+	}
+	for _, callback := range callbacks {
+		callback(ready, health, err)
+	}
+}
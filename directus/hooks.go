@@ -0,0 +1,63 @@
+package directus
+
+// Optional lifecycle hooks, implement them on collection types.
+// Returning an error from Before* hooks aborts saving of the object
+
+type IBeforeCreate interface {
+	BeforeCreate() error
+}
+
+// Called for changed objects only, changes made by the hook are saved as well
+type IBeforeUpdate interface {
+	BeforeUpdate() error
+}
+
+// Called after object was created or patched
+type IAfterSave interface {
+	AfterSave()
+}
+
+type IBeforeDelete interface {
+	BeforeDelete() error
+}
+
+// Called for every loaded object, including related ones
+type IAfterLoad interface {
+	AfterLoad()
+}
+
+func runAfterLoad(obj IDirectusObject) {
+	objects := append(trackedObjects(obj), obj)
+	for _, o := range objects {
+		if hook, ok := o.(IAfterLoad); ok {
+			hook.AfterLoad()
+		}
+	}
+}
+
+func runBeforeCreate(obj IDirectusObject) error {
+	if hook, ok := obj.(IBeforeCreate); ok {
+		return hook.BeforeCreate()
+	}
+	return nil
+}
+
+func runBeforeUpdate(obj IDirectusObject) error {
+	if hook, ok := obj.(IBeforeUpdate); ok {
+		return hook.BeforeUpdate()
+	}
+	return nil
+}
+
+func runAfterSave(obj IDirectusObject) {
+	if hook, ok := obj.(IAfterSave); ok {
+		hook.AfterSave()
+	}
+}
+
+func runBeforeDelete(obj IDirectusObject) error {
+	if hook, ok := obj.(IBeforeDelete); ok {
+		return hook.BeforeDelete()
+	}
+	return nil
+}
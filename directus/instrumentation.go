@@ -0,0 +1,139 @@
+package directus
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	ATTR_COLLECTION  = "directus.collection"
+	ATTR_OPERATION   = "directus.operation"
+	ATTR_FILTER_SIZE = "directus.filter_size"
+	ATTR_ITEM_COUNT  = "directus.item_count"
+	ATTR_STATUS_CODE = "http.status_code"
+)
+
+// Span of a single unit of work, usually one http call to directus
+type IDirectusSpan interface {
+	SetAttribute(key string, value any)
+	RecordError(err error)
+	End()
+}
+
+// Tracer adapter, ctx is the context passed by caller with WithContext or UseContext,
+// so adapters may attach spans to traces of caller. Parent is the enclosing directus span, nil for root spans
+type IDirectusTracer interface {
+	StartSpan(ctx context.Context, parent IDirectusSpan, name string) IDirectusSpan
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpan returns ctx carrying span, operations started with it become children of span
+func ContextWithSpan(ctx context.Context, span IDirectusSpan) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext returns directus span carried by ctx, nil when there is none
+func SpanFromContext(ctx context.Context) IDirectusSpan {
+	span, _ := ctx.Value(spanContextKey{}).(IDirectusSpan)
+	return span
+}
+
+// Metrics adapter, called once for every finished http call
+type IDirectusMetrics interface {
+	ObserveRequest(collection, operation string, statusCode int, duration time.Duration, err error)
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(key string, value any) {}
+func (noopSpan) RecordError(err error)              {}
+func (noopSpan) End()                               {}
+
+type noopTracer struct{}
+
+func (noopTracer) StartSpan(ctx context.Context, parent IDirectusSpan, name string) IDirectusSpan {
+	return noopSpan{}
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveRequest(collection, operation string, statusCode int, duration time.Duration, err error) {
+}
+
+func (h *DirectusApi) UseTracer(tracer IDirectusTracer) {
+	if tracer == nil {
+		tracer = noopTracer{}
+	}
+	h.pipelineMutex.Lock()
+	defer h.pipelineMutex.Unlock()
+	h.tracer = tracer
+}
+
+func (h *DirectusApi) UseMetrics(metrics IDirectusMetrics) {
+	if metrics == nil {
+		metrics = noopMetrics{}
+	}
+	h.pipelineMutex.Lock()
+	defer h.pipelineMutex.Unlock()
+	h.metrics = metrics
+}
+
+type directusOperation struct {
+	api *DirectusApi
+	// Carries span of operation, child operations are started with it
+	ctx        context.Context
+	collection string
+	name       string
+	span       IDirectusSpan
+	startTime  time.Time
+	statusCode int
+	sent       bool
+}
+
+func (h *DirectusApi) startOperation(ctx context.Context, collection, name string) *directusOperation {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	h.pipelineMutex.RLock()
+	tracer := h.tracer
+	h.pipelineMutex.RUnlock()
+	span := tracer.StartSpan(ctx, SpanFromContext(ctx), "directus."+name)
+	span.SetAttribute(ATTR_COLLECTION, collection)
+	span.SetAttribute(ATTR_OPERATION, name)
+	return &directusOperation{
+		api:        h,
+		ctx:        ContextWithSpan(ctx, span),
+		collection: collection,
+		name:       name,
+		span:       span,
+		startTime:  time.Now(),
+	}
+}
+
+func (h *directusOperation) do(req *http.Request) (*http.Response, error) {
+	h.sent = true
+	req = req.WithContext(h.ctx)
+	resp, err := h.api.roundTrip(DirectusRequestInfo{Collection: h.collection, Operation: h.name}, req)
+	if err != nil {
+		return nil, err
+	}
+	h.statusCode = resp.StatusCode
+	h.span.SetAttribute(ATTR_STATUS_CODE, resp.StatusCode)
+	return resp, nil
+}
+
+func (h *directusOperation) end(err error) {
+	if err != nil {
+		h.span.RecordError(err)
+	}
+	// Parent operations (SaveChanges) are measured by their child calls
+	if h.sent {
+		h.api.pipelineMutex.RLock()
+		metrics := h.api.metrics
+		h.api.pipelineMutex.RUnlock()
+		metrics.ObserveRequest(h.collection, h.name, h.statusCode, time.Since(h.startTime), err)
+	}
+	h.span.End()
+}
@@ -0,0 +1,134 @@
+package directus
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// Value of directus json field decoded into T.
+// Keys of json objects unknown to T are kept and sent back on marshal
+type JSON[T any] struct {
+	Value T
+	// False when field is null
+	Valid bool
+	raw   json.RawMessage
+}
+
+func NewJSON[T any](value T) JSON[T] {
+	return JSON[T]{Value: value, Valid: true}
+}
+
+func (h *JSON[T]) Set(value T) {
+	h.Value = value
+	h.Valid = true
+}
+
+func (h JSON[T]) MarshalJSON() ([]byte, error) {
+	if !h.Valid {
+		return []byte("null"), nil
+	}
+	data, err := json.Marshal(h.Value)
+	if err != nil {
+		return nil, err
+	}
+	// Maps know all of their keys, only structs may miss some
+	t := reflect.TypeOf(h.Value)
+	if h.raw == nil || t == nil || t.Kind() != reflect.Struct {
+		return data, nil
+	}
+	known := map[string]json.RawMessage{}
+	original := map[string]json.RawMessage{}
+	if json.Unmarshal(data, &known) != nil || json.Unmarshal(h.raw, &original) != nil {
+		return data, nil
+	}
+	for k, v := range original {
+		if _, exists := known[k]; !exists {
+			known[k] = v
+		}
+	}
+	return json.Marshal(known)
+}
+
+func (h *JSON[T]) UnmarshalJSON(data []byte) error {
+	var value T
+	h.Value = value
+	h.raw = nil
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		h.Valid = false
+		return nil
+	}
+	err := json.Unmarshal(data, &h.Value)
+	if err != nil {
+		return err
+	}
+	h.raw = append(json.RawMessage{}, data...)
+	h.Valid = true
+	return nil
+}
+
+func (h JSON[T]) Equal(other JSON[T]) bool {
+	if h.Valid != other.Valid {
+		return false
+	}
+	return !h.Valid || reflect.DeepEqual(h.Value, other.Value)
+}
+
+func (h JSON[T]) DeepCopy() JSON[T] {
+	copied := JSON[T]{Valid: h.Valid}
+	if h.raw != nil {
+		copied.raw = append(json.RawMessage{}, h.raw...)
+	}
+	if h.Valid {
+		reflect.ValueOf(&copied.Value).Elem().Set(deepCopyValue(reflect.ValueOf(&h.Value).Elem()))
+	}
+	return copied
+}
+
+func deepCopyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopyValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyValue(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopyValue(v.Field(i)))
+			}
+		}
+		return c
+	}
+	return v
+}
@@ -0,0 +1,289 @@
+package directus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var (
+	collectionTypes      = map[string]func() IDirectusObject{}
+	collectionTypesMutex sync.RWMutex
+)
+
+// Types of collections are registered by NewDirectusCollectionAccessor, so related items of many-to-any relations can be decoded
+func registerCollectionType(collection string, factory func() IDirectusObject) {
+	collectionTypesMutex.Lock()
+	defer collectionTypesMutex.Unlock()
+	collectionTypes[collection] = factory
+}
+
+func newCollectionObject(collection string) (IDirectusObject, bool) {
+	collectionTypesMutex.RLock()
+	defer collectionTypesMutex.RUnlock()
+	factory, exists := collectionTypes[collection]
+	if !exists {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// Related item of many-to-any relation, Collection tells type of Item.
+// Item types are known once their collection accessors are created, so decode M2A values only after New
+type M2A struct {
+	Collection string
+	// Nil when only key of item was fetched
+	Item IDirectusObject
+	Key  string
+	// Key of junction row when junction was not expanded, other fields are empty then
+	Junction string
+}
+
+func NewM2A(item IDirectusObject) M2A {
+	return M2A{Collection: item.CollectionName(), Item: item, Key: item.GetId()}
+}
+
+// DecodeM2A decodes item of junction row, data is either key or object of collection.
+// Fails for objects of collections without accessor, accessors of generated types are created by New
+func DecodeM2A(collection string, data json.RawMessage) (M2A, error) {
+	result := M2A{Collection: collection}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return result, nil
+	}
+	if data[0] != '{' {
+		result.Key = strings.Trim(string(data), `"`)
+		return result, nil
+	}
+	obj, exists := newCollectionObject(collection)
+	if !exists {
+		return result, fmt.Errorf("type of collection %s is not registered", collection)
+	}
+	err := json.Unmarshal(data, obj)
+	if err != nil {
+		return result, err
+	}
+	result.Item = obj
+	result.Key = obj.GetId()
+	return result, nil
+}
+
+// M2AItem returns related item if it is loaded and belongs to collection of type V
+func M2AItem[V IDirectusObject](union M2A) (*V, bool) {
+	item, ok := union.Item.(any).(*V)
+	return item, ok
+}
+
+func (h M2A) key() string {
+	if h.Item != nil {
+		return h.Item.GetId()
+	}
+	return h.Key
+}
+
+// Equal compares referenced items, changes inside of item are tracked by item itself
+func (h M2A) Equal(other M2A) bool {
+	return h.Collection == other.Collection && h.key() == other.key() && h.Junction == other.Junction
+}
+
+func (h M2A) DeepCopy() M2A {
+	copied := h
+	if h.Item != nil {
+		copied.Item = h.Item.DeepCopy()
+	}
+	return copied
+}
+
+// Track returns loaded item with its relations, new items are created together with junction row instead
+func (h M2A) Track() []IDirectusObject {
+	if h.Item == nil || isZeroKey(h.Item.GetId()) {
+		return nil
+	}
+	return append([]IDirectusObject{h.Item}, h.Item.Track()...)
+}
+
+// ItemPayload is value of "item" field sent to directus, new items are created together with junction row
+func (h M2A) ItemPayload() any {
+	if h.Item == nil {
+		if h.Key == "" {
+			return nil
+		}
+		return h.Key
+	}
+	if key := h.Item.GetId(); key != "" && !isZeroKey(key) {
+		return key
+	}
+	return insertPayload(h.Item)
+}
+
+func isZeroKey(key string) bool {
+	return key == "0" || key == "00000000-0000-0000-0000-000000000000"
+}
+
+type m2aJSON struct {
+	Id         json.RawMessage `json:"id,omitempty"`
+	Collection string          `json:"collection"`
+	Item       json.RawMessage `json:"item"`
+}
+
+func (h M2A) MarshalJSON() ([]byte, error) {
+	if h.Collection == "" {
+		if h.Junction != "" {
+			return json.Marshal(h.Junction)
+		}
+		return []byte("null"), nil
+	}
+	item, err := json.Marshal(h.ItemPayload())
+	if err != nil {
+		return nil, err
+	}
+	row := m2aJSON{Collection: h.Collection, Item: item}
+	if h.Junction != "" {
+		row.Id, _ = json.Marshal(h.Junction)
+	}
+	return json.Marshal(row)
+}
+
+// Accepts {"collection": ..., "item": ...} shape of junction row or just junction key
+func (h *M2A) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		*h = M2A{}
+		return nil
+	}
+	if len(data) != 0 && data[0] != '{' {
+		*h = M2A{Junction: strings.Trim(string(data), `"`)}
+		return nil
+	}
+	raw := m2aJSON{}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	union, err := DecodeM2A(raw.Collection, raw.Item)
+	if err != nil {
+		return err
+	}
+	union.Junction = strings.Trim(string(raw.Id), `"`)
+	*h = union
+	return nil
+}
+
+// Value of many-to-any field, one entry per junction row.
+// Rows are saved through relational payload of parent object, loaded items are tracked with parent
+type M2AList struct {
+	Items []M2A
+}
+
+func (h *M2AList) Add(item IDirectusObject) {
+	h.Items = append(h.Items, NewM2A(item))
+}
+
+// Remove drops junction rows referring to item, the item itself is kept
+func (h *M2AList) Remove(item IDirectusObject) {
+	items := make([]M2A, 0, len(h.Items))
+	for _, union := range h.Items {
+		if union.Collection != item.CollectionName() || union.key() != item.GetId() {
+			items = append(items, union)
+		}
+	}
+	h.Items = items
+}
+
+func (h M2AList) Track() []IDirectusObject {
+	objects := []IDirectusObject{}
+	for _, union := range h.Items {
+		objects = append(objects, union.Track()...)
+	}
+	return objects
+}
+
+func (h M2AList) DeepCopy() M2AList {
+	if h.Items == nil {
+		return h
+	}
+	copied := M2AList{Items: make([]M2A, 0, len(h.Items))}
+	for _, union := range h.Items {
+		copied.Items = append(copied.Items, union.DeepCopy())
+	}
+	return copied
+}
+
+func (h M2AList) Equal(other M2AList) bool {
+	return h.Diff(other) == nil
+}
+
+func (h M2A) row() map[string]any {
+	row := map[string]any{"collection": h.Collection, "item": h.ItemPayload()}
+	if h.Junction != "" {
+		row["id"] = h.Junction
+	}
+	return row
+}
+
+// Diff returns directus relational payload with created, updated and deleted junction rows, nil when nothing changed.
+// Changes inside of loaded items are saved by items themselves
+func (h M2AList) Diff(old M2AList) map[string]any {
+	oldItems := map[string]M2A{}
+	for _, union := range old.Items {
+		if union.Junction != "" {
+			oldItems[union.Junction] = union
+		}
+	}
+	create := []map[string]any{}
+	update := []map[string]any{}
+	for _, union := range h.Items {
+		oldItem, exists := oldItems[union.Junction]
+		if union.Junction == "" || !exists {
+			create = append(create, union.row())
+			continue
+		}
+		delete(oldItems, union.Junction)
+		if !union.Equal(oldItem) {
+			update = append(update, union.row())
+		}
+	}
+	remove := []string{}
+	for id := range oldItems {
+		remove = append(remove, id)
+	}
+	if len(create) == 0 && len(update) == 0 && len(remove) == 0 {
+		return nil
+	}
+	return map[string]any{"create": create, "update": update, "delete": remove}
+}
+
+func (h M2AList) RelationalDiff(old any) any {
+	previous, _ := old.(M2AList)
+	diff := h.Diff(previous)
+	if diff == nil {
+		return nil
+	}
+	return diff
+}
+
+func (h M2AList) RelationalCreate() any {
+	if len(h.Items) == 0 {
+		return nil
+	}
+	rows := make([]map[string]any, 0, len(h.Items))
+	for _, union := range h.Items {
+		rows = append(rows, union.row())
+	}
+	return rows
+}
+
+func (h M2AList) MarshalJSON() ([]byte, error) {
+	if h.Items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(h.Items)
+}
+
+// Accepts expanded junction rows as well as plain junction keys
+func (h *M2AList) UnmarshalJSON(data []byte) error {
+	h.Items = nil
+	return json.Unmarshal(data, &h.Items)
+}
@@ -0,0 +1,71 @@
+package directus
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type page struct {
+	IDirectusObject
+	Id     int     `json:"id"`
+	Blocks M2AList `json:"blocks"`
+}
+
+func (cf *page) DeepCopy() IDirectusObject {
+	return &page{Id: cf.Id, Blocks: cf.Blocks.DeepCopy()}
+}
+func (cf *page) Diff(old IDirectusObject) map[string]interface{} { return map[string]interface{}{} }
+func (cf *page) Track() []IDirectusObject                        { return []IDirectusObject{} }
+func (cf *page) GetId() string                                   { return fmt.Sprintf("%d", cf.Id) }
+func (cf *page) CollectionName() string                          { return "page" }
+func (cf *page) Map() map[string]interface{}                     { return map[string]interface{}{"id": cf.Id} }
+
+func TestM2AListKeepsJunctionKeys(t *testing.T) {
+	registerCollectionType("product", func() IDirectusObject { return new(Product) })
+	productId := uuid.New()
+	data := fmt.Sprintf(`{"id":1,"blocks":[7,{"id":8,"collection":"product","item":{"id":"%s"}}]}`, productId)
+	var p page
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(p.Blocks.Items) != 2 || p.Blocks.Items[0].Junction != "7" || p.Blocks.Items[1].Junction != "8" {
+		t.Fatalf("blocks = %+v, want junctions 7 and 8", p.Blocks.Items)
+	}
+	product, ok := M2AItem[Product](p.Blocks.Items[1])
+	if !ok || product.Id != productId {
+		t.Fatalf("item = %v, want product %s", p.Blocks.Items[1].Item, productId)
+	}
+	if tracked := trackedObjects(&p); len(tracked) != 1 || tracked[0] != product {
+		t.Errorf("tracked = %v, want loaded product", tracked)
+	}
+}
+
+func TestM2AListRelationalDiff(t *testing.T) {
+	product := &Product{Id: uuid.New()}
+	original := &page{Id: 1, Blocks: M2AList{Items: []M2A{{Junction: "7"}, {Collection: "product", Item: product, Key: product.GetId(), Junction: "8"}}}}
+	actual := original.DeepCopy().(*page)
+	actual.Blocks.Items = actual.Blocks.Items[1:]
+	actual.Blocks.Add(&Slot{})
+
+	diff := relationalDiff(actual, original)["blocks"].(map[string]any)
+	if !reflect.DeepEqual(diff["delete"], []string{"7"}) {
+		t.Errorf("delete = %v, want [7]", diff["delete"])
+	}
+	create := diff["create"].([]map[string]any)
+	if len(create) != 1 || create[0]["collection"] != "slot" || create[0]["id"] != nil {
+		t.Errorf("create = %v, want new slot row", create)
+	}
+	if update := diff["update"].([]map[string]any); len(update) != 0 {
+		t.Errorf("update = %v, want none", update)
+	}
+	if tracked := trackedObjects(actual); len(tracked) != 1 || tracked[0] != actual.Blocks.Items[0].Item {
+		t.Errorf("tracked = %v, want loaded product only", tracked)
+	}
+	if relationalDiff(original, original.DeepCopy())["blocks"] != nil {
+		t.Error("unchanged blocks have diff")
+	}
+}
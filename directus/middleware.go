@@ -0,0 +1,44 @@
+package directus
+
+import (
+	"net/http"
+)
+
+// Describes the call that produced the request, Collection is empty for system endpoints
+type DirectusRequestInfo struct {
+	Collection string
+	Operation  string
+}
+
+type DirectusRoundTrip func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps every outgoing request and incoming response.
+// It must call next to continue the chain, or return its own response to short-circuit it
+type DirectusMiddleware func(info DirectusRequestInfo, req *http.Request, next DirectusRoundTrip) (*http.Response, error)
+
+// Registers middlewares, they are executed in registration order for requests
+// and in reverse order for responses. Requests already running keep the chain they started with,
+// use WithMiddleware to have middlewares in place before the first request
+func (h *DirectusApi) Use(middleware ...DirectusMiddleware) {
+	h.pipelineMutex.Lock()
+	defer h.pipelineMutex.Unlock()
+	// Copy, so chains of running requests are never modified
+	middlewares := make([]DirectusMiddleware, 0, len(h.middlewares)+len(middleware))
+	middlewares = append(middlewares, h.middlewares...)
+	h.middlewares = append(middlewares, middleware...)
+}
+
+func (h *DirectusApi) roundTrip(info DirectusRequestInfo, req *http.Request) (*http.Response, error) {
+	h.pipelineMutex.RLock()
+	middlewares := h.middlewares
+	h.pipelineMutex.RUnlock()
+	client := &http.Client{}
+	next := DirectusRoundTrip(client.Do)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		mw, inner := middlewares[i], next
+		next = func(req *http.Request) (*http.Response, error) {
+			return mw(info, req, inner)
+		}
+	}
+	return next(req)
+}
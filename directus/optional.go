@@ -0,0 +1,121 @@
+package directus
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+type optionalState uint8
+
+const (
+	optionalAbsent = optionalState(iota)
+	optionalNull
+	optionalValue
+)
+
+type IDirectusOptional interface {
+	IsSet() bool
+}
+
+// Field value distinguishing absent (not fetched or not set), null and a value.
+// Absent fields are left out of Diff and create payloads, zero value is absent
+type Optional[T any] struct {
+	value T
+	state optionalState
+}
+
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, state: optionalValue}
+}
+
+func Null[T any]() Optional[T] {
+	return Optional[T]{state: optionalNull}
+}
+
+// IsSet reports whether field holds null or a value
+func (h Optional[T]) IsSet() bool {
+	return h.state != optionalAbsent
+}
+
+func (h Optional[T]) IsNull() bool {
+	return h.state == optionalNull
+}
+
+func (h Optional[T]) HasValue() bool {
+	return h.state == optionalValue
+}
+
+func (h Optional[T]) Get() (T, bool) {
+	return h.value, h.state == optionalValue
+}
+
+func (h Optional[T]) OrElse(fallback T) T {
+	if h.state == optionalValue {
+		return h.value
+	}
+	return fallback
+}
+
+func (h *Optional[T]) Set(value T) {
+	h.value = value
+	h.state = optionalValue
+}
+
+func (h *Optional[T]) SetNull() {
+	var zero T
+	h.value = zero
+	h.state = optionalNull
+}
+
+func (h *Optional[T]) Unset() {
+	var zero T
+	h.value = zero
+	h.state = optionalAbsent
+}
+
+func (h Optional[T]) Equal(other Optional[T]) bool {
+	if h.state != other.state {
+		return false
+	}
+	return h.state != optionalValue || valuesEqual(h.value, other.value)
+}
+
+func (h Optional[T]) DeepCopy() Optional[T] {
+	copied := Optional[T]{state: h.state}
+	reflect.ValueOf(&copied.value).Elem().Set(deepCopyValue(reflect.ValueOf(&h.value).Elem()))
+	return copied
+}
+
+// Absent value is marshaled as null, use it in generated types or drop it before marshaling
+func (h Optional[T]) MarshalJSON() ([]byte, error) {
+	if h.state != optionalValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(h.value)
+}
+
+// Only called for keys present in json, so missing keys stay absent
+func (h *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		h.SetNull()
+		return nil
+	}
+	var value T
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+	h.Set(value)
+	return nil
+}
+
+// Removes absent optional values from payload
+func dropUnset(payload map[string]any) map[string]any {
+	for k, v := range payload {
+		if optional, ok := v.(IDirectusOptional); ok && !optional.IsSet() {
+			delete(payload, k)
+		}
+	}
+	return payload
+}
@@ -0,0 +1,95 @@
+package directus
+
+import (
+	"fmt"
+)
+
+const (
+	META_TOTAL_COUNT  = "total_count"
+	META_FILTER_COUNT = "filter_count"
+)
+
+type CollectionPage[V IDirectusObject] struct {
+	Items []*V
+	// Count of all items in collection
+	TotalCount int
+	// Count of items matching query filter
+	FilterCount int
+}
+
+// ToPage works like ToSlice, but also requests total and filtered item counts
+func (h *CollectionQuery[K, V]) ToPage(accessContext *DirectusAccessContext) (_ *CollectionPage[V], err error) {
+	op := h.Collection.api.startOperation(h.context(), h.Collection.collectionName, "ToPage")
+	defer func() { op.end(err) }()
+
+	q, err := h.buildQuery(op)
+	if err != nil {
+		return nil, err
+	}
+	q.Set("meta", META_TOTAL_COUNT+","+META_FILTER_COUNT)
+	item := DirectusResponse[[]*V]{}
+	err = h.fetch(op, q, &item)
+	if err != nil {
+		return nil, err
+	}
+	if item.Meta == nil {
+		return nil, fmt.Errorf("directus response has no meta")
+	}
+
+	op.span.SetAttribute(ATTR_ITEM_COUNT, len(item.Data))
+	for _, e := range item.Data {
+		h.track(accessContext, e)
+	}
+	return &CollectionPage[V]{
+		Items:       item.Data,
+		TotalCount:  item.Meta.TotalCount,
+		FilterCount: item.Meta.FilterCount,
+	}, nil
+}
+
+// ForEachPage walks query results page by page until callback returns false or items run out
+func (h *CollectionQuery[K, V]) ForEachPage(accessContext *DirectusAccessContext, pageSize int, callback func(page *CollectionPage[V]) bool) error {
+	if pageSize <= 0 {
+		return fmt.Errorf("page size must be positive, got %d", pageSize)
+	}
+	offset := 0
+	if h.offset != nil {
+		offset = *h.offset
+	}
+	for {
+		h.Limit(pageSize).Offset(offset)
+		page, err := h.ToPage(accessContext)
+		if err != nil {
+			return err
+		}
+		if len(page.Items) == 0 || !callback(page) {
+			return nil
+		}
+		offset += len(page.Items)
+		if offset >= page.FilterCount {
+			return nil
+		}
+	}
+}
+
+// Count returns number of items matching query filter without fetching them
+func (h *CollectionQuery[K, V]) Count() (_ int, err error) {
+	op := h.Collection.api.startOperation(h.context(), h.Collection.collectionName, "Count")
+	defer func() { op.end(err) }()
+
+	q, err := h.buildQuery(op)
+	if err != nil {
+		return 0, err
+	}
+	q.Set("limit", "0")
+	q.Set("meta", META_FILTER_COUNT)
+	item := DirectusResponse[[]*V]{}
+	err = h.fetch(op, q, &item)
+	if err != nil {
+		return 0, err
+	}
+	if item.Meta == nil {
+		return 0, fmt.Errorf("directus response has no meta")
+	}
+	return item.Meta.FilterCount, nil
+}
@@ -0,0 +1,81 @@
+package directus
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Project selects fields described by json tags of T and decodes results into T, bypassing change tracking.
+// Nested structs, pointers and slices of structs select fields of relations, e.g.
+//
+//	type SlotReport struct {
+//		Id      uuid.UUID `json:"id"`
+//		Product struct {
+//			Name string `json:"name"`
+//		} `json:"product"`
+//	}
+//
+// selects "id,product.name". Field selectors set by Include are replaced.
+// T must be a struct, relations referring back to a type already being projected are skipped
+func Project[T any, K string | uuid.UUID | int, V IDirectusObject](query *CollectionQuery[K, V]) ([]T, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("projection type must be a struct, got %s", t)
+	}
+	query.fieldSelectors = projectionFields(t, "", map[reflect.Type]bool{})
+	return ToSliceAs[T](query)
+}
+
+// Visited holds types on current path, so self-referencing structs do not recurse forever
+func projectionFields(t reflect.Type, prefix string, visited map[reflect.Type]bool) []string {
+	fields := []string{}
+	visited[t] = true
+	defer delete(visited, t)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer || ft.Kind() == reflect.Slice || ft.Kind() == reflect.Array {
+			ft = ft.Elem()
+		}
+		isRelation := ft.Kind() == reflect.Struct && !isLeafType(ft)
+		if isRelation && visited[ft] {
+			continue
+		}
+		if f.Anonymous && name == "" && isRelation {
+			fields = append(fields, projectionFields(ft, prefix, visited)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if isRelation {
+			fields = append(fields, projectionFields(ft, prefix+name+".", visited)...)
+		} else {
+			fields = append(fields, prefix+name)
+		}
+	}
+	return fields
+}
+
+// Structs decoding themselves from json, like time.Time, are plain values rather than relations
+func isLeafType(t reflect.Type) bool {
+	p := reflect.PointerTo(t)
+	return p.Implements(jsonUnmarshalerType) || p.Implements(textUnmarshalerType)
+}
@@ -0,0 +1,104 @@
+package directus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Field changed both locally and on the server since object was loaded
+type FieldConflict struct {
+	Collection string
+	Id         string
+	Field      string
+	Original   any
+	Local      any
+	Server     any
+}
+
+func (h FieldConflict) String() string {
+	return fmt.Sprintf("%s[%s].%s", h.Collection, h.Id, h.Field)
+}
+
+// Reload fetches current server state of tracked object, overwrites its fields in place
+// and resets tracking snapshot. Related objects are kept, reload them separately.
+// Returns fields where unsaved local changes were overwritten by different server values
+func (h *DirectusAccessContext) Reload(obj IDirectusObject) ([]FieldConflict, error) {
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	ref, exists := h.trackingObjects[obj]
+	if !exists {
+		return nil, fmt.Errorf("object of type [%s] is not tracked", obj.CollectionName())
+	}
+	return h.reload(h.context(), ref)
+}
+
+// ReloadAll reloads every tracked object, see Reload
+func (h *DirectusAccessContext) ReloadAll() (_ []FieldConflict, err error) {
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	op := h.api.startOperation(h.context(), "", "ReloadAll")
+	defer func() { op.end(err) }()
+
+	conflicts := []FieldConflict{}
+	for _, ref := range h.trackingObjects {
+		c, err := h.reload(op.ctx, ref)
+		if err != nil {
+			return conflicts, err
+		}
+		conflicts = append(conflicts, c...)
+	}
+	return conflicts, nil
+}
+
+func (h *DirectusAccessContext) reload(ctx context.Context, ref trackingRef) ([]FieldConflict, error) {
+	fresh, err := ref.OwnerCollection.load(ctx, ref.Original.GetId())
+	if err != nil {
+		return nil, err
+	}
+	conflicts := findConflicts(ref.Original, ref.Actual, fresh)
+	copyFields(ref.Actual, fresh, fresh.Map())
+	runAfterLoad(ref.Actual)
+	ref.Original = ref.Actual.DeepCopy()
+	h.trackingObjects[ref.Actual] = ref
+	return conflicts, nil
+}
+
+// Compares local and server changes of the same original object
+func findConflicts(original, local, server IDirectusObject) []FieldConflict {
+	conflicts := []FieldConflict{}
+	localDiff := local.Diff(original)
+	serverDiff := server.Diff(original)
+	originalValues := original.Map()
+	for field, localValue := range localDiff {
+		serverValue, changed := serverDiff[field]
+		if !changed || valuesEqual(localValue, serverValue) {
+			continue
+		}
+		conflicts = append(conflicts, FieldConflict{
+			Collection: original.CollectionName(),
+			Id:         original.GetId(),
+			Field:      field,
+			Original:   originalValues[field],
+			Local:      localValue,
+			Server:     serverValue,
+		})
+	}
+	return conflicts
+}
+
+// Copies struct fields with json names present in fields from src to dst, both must be pointers to the same type
+func copyFields(dst, src IDirectusObject, fields map[string]any) {
+	dv := reflect.ValueOf(dst).Elem()
+	sv := reflect.ValueOf(src).Elem()
+	t := dv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if _, ok := fields[name]; !ok || !f.IsExported() {
+			continue
+		}
+		dv.Field(i).Set(sv.Field(i))
+	}
+}
@@ -0,0 +1,98 @@
+package directus
+
+import "context"
+
+// Undoes one successfully saved object
+type compensation struct {
+	result  SaveResult
+	undo    func(ctx context.Context) error
+	restore func()
+}
+
+// UseSaga makes failed SaveChanges revert already saved objects with compensating requests:
+// created objects are deleted, patched objects get their original values back and deleted objects are created again.
+// Reverted objects stay pending, so SaveChanges may be retried
+func (h *DirectusAccessContext) UseSaga(enabled bool) {
+	h.trackingObjectsMutex.Lock()
+	defer h.trackingObjectsMutex.Unlock()
+	h.saga = enabled
+}
+
+func (h *DirectusAccessContext) undoCreate(result SaveResult) compensation {
+	obj := result.Object
+	return compensation{
+		result: SaveResult{Collection: result.Collection, Id: result.Id, Action: SAVE_DELETE, Object: obj},
+		undo: func(ctx context.Context) error {
+			return h.api.collectionsAccessors[obj.CollectionName()].delete(ctx, result.Id)
+		},
+		restore: func() {
+			delete(h.trackingObjects, obj)
+			h.pendingInserts = append(h.pendingInserts, obj)
+		},
+	}
+}
+
+func (h *DirectusAccessContext) undoPatch(result SaveResult, ref trackingRef, diff map[string]any) compensation {
+	original := ref.Original
+	values := original.Map()
+	// Relational fields are reverted with inverse payload, rows created by the patch are not known and stay
+	relational := relationalFields(original)
+	reverse := relationalDiff(original, ref.Actual)
+	patch := map[string]any{}
+	for field := range diff {
+		if _, exists := relational[field]; !exists {
+			patch[field] = values[field]
+		} else if change, exists := reverse[field]; exists {
+			patch[field] = change
+		}
+	}
+	return compensation{
+		result: SaveResult{Collection: result.Collection, Id: result.Id, Action: SAVE_PATCH, Object: ref.Actual},
+		undo: func(ctx context.Context) error {
+			return ref.OwnerCollection.patch(ctx, patch, result.Id)
+		},
+		restore: func() {
+			ref.Original = original
+			h.trackingObjects[ref.Actual] = ref
+		},
+	}
+}
+
+// Snapshot is the row loaded right before deletion, it is recreated with the same key and relations
+func (h *DirectusAccessContext) undoDelete(result SaveResult, snapshot IDirectusObject) compensation {
+	obj := result.Object
+	values := objectPayload(snapshot)
+	ref, tracked := h.trackingObjects[obj]
+	return compensation{
+		result: SaveResult{Collection: result.Collection, Id: result.Id, Action: SAVE_CREATE, Object: obj},
+		undo: func(ctx context.Context) error {
+			_, err := h.api.collectionsAccessors[obj.CollectionName()].create(ctx, values)
+			return err
+		},
+		restore: func() {
+			if tracked {
+				h.trackingObjects[obj] = ref
+			}
+			h.pendingDeletes = append(h.pendingDeletes, obj)
+		},
+	}
+}
+
+// Reverts saved objects in reverse order when report has failures and saga mode is enabled
+func (h *DirectusAccessContext) compensate(ctx context.Context, report *SaveReport, undo []compensation) error {
+	if report.err() == nil || !h.saga {
+		return report.err()
+	}
+	for i := len(undo) - 1; i >= 0; i-- {
+		c := undo[i]
+		result := c.result
+		result.Err = c.undo(ctx)
+		if result.Err != nil {
+			h.api.errLogger.Printf("Failed to compensate object of type [%s]: %s\n", result.Collection, result.Err.Error())
+		} else {
+			c.restore()
+		}
+		report.Compensations = append(report.Compensations, result)
+	}
+	return report.err()
+}
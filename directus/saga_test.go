@@ -0,0 +1,116 @@
+package directus
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSaveChangesRetryDoesNotTouchDeletedObjects(t *testing.T) {
+	server := newFakeDirectus()
+	productId, promocodeId := uuid.NewString(), uuid.NewString()
+	server.put("product", productId, map[string]any{"name": "Proxy", "price": "1.00"})
+	server.put("promocode", promocodeId, map[string]any{"code": "SALE", "discount": "0.10"})
+	api := newTestApi(t, server)
+	ctx := api.NewDirectusAccessContext()
+	ctx.UseContinueOnError(true)
+	product := loadProduct(t, api, ctx, productId)
+	promocode, err := api.PromocodeCollectionAccessor.LoadById(uuid.MustParse(promocodeId), ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	product.Name = "Deleted anyway"
+	promocode.Code = "SALE2"
+	ctx.Remove(product)
+	server.setFail("PATCH /items/promocode/"+promocodeId, true)
+
+	_, err = ctx.SaveChangesWithReport()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if ctx.IsTracked(product) {
+		t.Error("deleted product is still tracked")
+	}
+
+	server.setFail("PATCH /items/promocode/"+promocodeId, false)
+	server.sent()
+	_, err = ctx.SaveChangesWithReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, request := range server.sent() {
+		if request != "PATCH /items/promocode/"+promocodeId {
+			t.Errorf("retry sent %s, want only the failed promocode patch", request)
+		}
+	}
+}
+
+func TestSagaRevertsCreate(t *testing.T) {
+	server := newFakeDirectus()
+	productId := uuid.NewString()
+	server.put("product", productId, map[string]any{"name": "Proxy", "price": "1.00"})
+	api := newTestApi(t, server)
+	ctx := api.NewDirectusAccessContext()
+	ctx.UseSaga(true)
+	product := loadProduct(t, api, ctx, productId)
+	product.Name = "Fast proxy"
+	location := &Location{Code: "de"}
+	ctx.Add(location)
+	server.setFail("PATCH /items/product/"+productId, true)
+
+	report, err := ctx.SaveChangesWithReport()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if len(report.Compensations) != 1 || report.Compensations[0].Err != nil {
+		t.Fatalf("compensations = %v, want reverted create", report.Compensations)
+	}
+	if !containsRequest(server.sent(), "DELETE /items/location/"+location.Id.String()) {
+		t.Error("created location was not deleted")
+	}
+	if ctx.IsTracked(location) {
+		t.Error("reverted location is still tracked")
+	}
+
+	// Reverted create stays pending
+	server.setFail("PATCH /items/product/"+productId, false)
+	_, err = ctx.SaveChangesWithReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !containsRequest(server.sent(), "POST /items/location") {
+		t.Error("reverted location was not created on retry")
+	}
+}
+
+func TestSagaRestoresDeletedRowWithRelations(t *testing.T) {
+	server := newFakeDirectus()
+	locationId, productId, secondId := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	server.put("location", locationId, map[string]any{"code": "de", "name": "Germany"})
+	server.put("product", productId, map[string]any{"name": "Proxy", "price": "1.00", "location": locationId})
+	server.put("product", secondId, map[string]any{"name": "Other", "price": "2.00"})
+	api := newTestApi(t, server)
+	ctx := api.NewDirectusAccessContext()
+	ctx.UseSaga(true)
+	product := loadProduct(t, api, ctx, productId)
+	second := loadProduct(t, api, ctx, secondId)
+	ctx.Remove(product)
+	ctx.Remove(second)
+	server.setFail("DELETE /items/product/"+secondId, true)
+	server.sent()
+
+	report, err := ctx.SaveChangesWithReport()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if len(report.Compensations) != 1 || report.Compensations[0].Err != nil {
+		t.Fatalf("compensations = %v, want recreated product", report.Compensations)
+	}
+	restored := server.body("POST /items/product")
+	if restored["id"] != productId || restored["location"] != locationId {
+		t.Errorf("restored row = %v, want original key and location", restored)
+	}
+	if !ctx.IsTracked(product) {
+		t.Error("restored product is not tracked")
+	}
+}
@@ -0,0 +1,97 @@
+package directus
+
+import (
+	"fmt"
+)
+
+type SaveAction string
+
+const (
+	SAVE_CREATE = SaveAction("create")
+	SAVE_PATCH  = SaveAction("patch")
+	SAVE_DELETE = SaveAction("delete")
+)
+
+type SaveResult struct {
+	Collection string          `json:"collection"`
+	Id         string          `json:"id"`
+	Action     SaveAction      `json:"action"`
+	Object     IDirectusObject `json:"-"`
+	Err        error           `json:"-"`
+}
+
+type SaveReport struct {
+	Results []SaveResult
+	// Requests reverting saved objects after a failure, see UseSaga
+	Compensations []SaveResult
+}
+
+func (h *SaveReport) Succeeded() []SaveResult {
+	results := []SaveResult{}
+	for _, r := range h.Results {
+		if r.Err == nil {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+func (h *SaveReport) Failed() []SaveResult {
+	results := []SaveResult{}
+	for _, r := range h.Results {
+		if r.Err != nil {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+func (h *SaveReport) FailedCompensations() []SaveResult {
+	results := []SaveResult{}
+	for _, r := range h.Compensations {
+		if r.Err != nil {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+func (h *SaveReport) err() error {
+	failed := h.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return &SaveChangesError{Failed: failed, FailedCompensations: h.FailedCompensations()}
+}
+
+// Returned by SaveChanges when some objects were not saved, supports errors.Is and errors.As for every cause
+type SaveChangesError struct {
+	Failed []SaveResult
+	// Saved objects that could not be reverted in saga mode, server state is inconsistent for them
+	FailedCompensations []SaveResult
+}
+
+func (h *SaveChangesError) Error() string {
+	msg := ""
+	if len(h.Failed) == 1 {
+		f := h.Failed[0]
+		msg = fmt.Sprintf("failed to %s object %s[%s]: %s", f.Action, f.Collection, f.Id, f.Err.Error())
+	} else {
+		msg = fmt.Sprintf("failed to save %d objects, first error: %s", len(h.Failed), h.Failed[0].Err.Error())
+	}
+	if len(h.FailedCompensations) != 0 {
+		msg += fmt.Sprintf(", failed to revert %d objects", len(h.FailedCompensations))
+	}
+	return msg
+}
+
+func (h *SaveChangesError) Unwrap() []error {
+	errs := make([]error, 0, len(h.Failed)+len(h.FailedCompensations))
+	for _, f := range h.Failed {
+		errs = append(errs, f.Err)
+	}
+	for _, f := range h.FailedCompensations {
+		errs = append(errs, f.Err)
+	}
+	return errs
+}
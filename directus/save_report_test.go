@@ -0,0 +1,224 @@
+package directus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// In-memory items endpoint, requests listed in fail are answered with a directus error
+type fakeDirectus struct {
+	mutex    sync.Mutex
+	items    map[string]map[string]map[string]any
+	fail     map[string]bool
+	requests []string
+	bodies   map[string]map[string]any
+}
+
+func newFakeDirectus() *fakeDirectus {
+	return &fakeDirectus{
+		items:  map[string]map[string]map[string]any{},
+		fail:   map[string]bool{},
+		bodies: map[string]map[string]any{},
+	}
+}
+
+func (h *fakeDirectus) put(collection, id string, values map[string]any) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.items[collection] == nil {
+		h.items[collection] = map[string]map[string]any{}
+	}
+	values["id"] = id
+	h.items[collection][id] = values
+}
+
+func (h *fakeDirectus) setFail(request string, fail bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.fail[request] = fail
+}
+
+func (h *fakeDirectus) sent() []string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	sent := h.requests
+	h.requests = nil
+	return sent
+}
+
+func (h *fakeDirectus) body(request string) map[string]any {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.bodies[request]
+}
+
+func (h *fakeDirectus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	request := r.Method + " " + r.URL.Path
+	h.requests = append(h.requests, request)
+	body := map[string]any{}
+	if data, _ := io.ReadAll(r.Body); len(data) != 0 {
+		json.Unmarshal(data, &body)
+	}
+	h.bodies[request] = body
+	if h.fail[request] {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"errors":[{"message":"%s failed"}]}`, request)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/items/"), "/")
+	collection, id := parts[0], ""
+	if len(parts) > 1 {
+		id = parts[1]
+	}
+	if h.items[collection] == nil {
+		h.items[collection] = map[string]map[string]any{}
+	}
+	item, exists := h.items[collection][id]
+	switch {
+	case r.Method == "POST":
+		if body["id"] == nil {
+			body["id"] = uuid.NewString()
+		}
+		h.items[collection][fmt.Sprint(body["id"])] = body
+		item, exists = body, true
+	case !exists:
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, `{"errors":[{"message":"You don't have permission to access this."}]}`)
+		return
+	case r.Method == "DELETE":
+		delete(h.items[collection], id)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case r.Method == "PATCH":
+		for k, v := range body {
+			item[k] = v
+		}
+	}
+	json.NewEncoder(w).Encode(map[string]any{"data": item})
+}
+
+func loadProduct(t *testing.T, api *DirectusApi, ctx *DirectusAccessContext, id string) *Product {
+	t.Helper()
+	product, err := api.ProductCollectionAccessor.LoadById(uuid.MustParse(id), ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return product
+}
+
+func containsRequest(requests []string, request string) bool {
+	for _, r := range requests {
+		if r == request {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSaveChangesStopsAtFirstFailureAndRetries(t *testing.T) {
+	server := newFakeDirectus()
+	api := newTestApi(t, server)
+	ctx := api.NewDirectusAccessContext()
+	first, second := &Location{Code: "de", Name: "Germany"}, &Location{Code: "fr", Name: "France"}
+	ctx.Add(first)
+	ctx.Add(second)
+	server.setFail("POST /items/location", true)
+
+	report, err := ctx.SaveChangesWithReport()
+	var saveErr *SaveChangesError
+	if !errors.As(err, &saveErr) {
+		t.Fatalf("err = %v, want SaveChangesError", err)
+	}
+	if len(report.Results) != 1 || len(report.Failed()) != 1 {
+		t.Fatalf("results = %v, want only the first failed create", report.Results)
+	}
+
+	server.setFail("POST /items/location", false)
+	server.sent()
+	report, err = ctx.SaveChangesWithReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Succeeded()) != 2 || len(server.sent()) != 2 {
+		t.Fatalf("retry saved %v, want both locations", report.Results)
+	}
+	if first.Id == uuid.Nil || second.Id == uuid.Nil {
+		t.Error("created objects did not get their keys")
+	}
+}
+
+func TestSaveChangesContinueOnErrorResendsOnlyFailed(t *testing.T) {
+	server := newFakeDirectus()
+	productId, promocodeId := uuid.NewString(), uuid.NewString()
+	server.put("product", productId, map[string]any{"name": "Proxy", "price": "1.00"})
+	server.put("promocode", promocodeId, map[string]any{"code": "SALE", "discount": "0.10"})
+	api := newTestApi(t, server)
+	ctx := api.NewDirectusAccessContext()
+	ctx.UseContinueOnError(true)
+	product := loadProduct(t, api, ctx, productId)
+	promocode, err := api.PromocodeCollectionAccessor.LoadById(uuid.MustParse(promocodeId), ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	product.Name = "Fast proxy"
+	promocode.Code = "SALE2"
+	server.setFail("PATCH /items/promocode/"+promocodeId, true)
+	server.sent()
+
+	report, err := ctx.SaveChangesWithReport()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if len(report.Succeeded()) != 1 || len(report.Failed()) != 1 {
+		t.Fatalf("results = %v, want one saved and one failed patch", report.Results)
+	}
+	if report.Failed()[0].Collection != "promocode" {
+		t.Errorf("failed = %v, want promocode", report.Failed())
+	}
+
+	server.setFail("PATCH /items/promocode/"+promocodeId, false)
+	server.sent()
+	_, err = ctx.SaveChangesWithReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent := server.sent()
+	if len(sent) != 1 || sent[0] != "PATCH /items/promocode/"+promocodeId {
+		t.Errorf("retry sent %v, want only the failed promocode patch", sent)
+	}
+}
+
+func TestSaveChangesKeepsSnapshotOfSavedObjects(t *testing.T) {
+	server := newFakeDirectus()
+	productId := uuid.NewString()
+	server.put("product", productId, map[string]any{"name": "Proxy", "price": "1.00"})
+	api := newTestApi(t, server)
+	ctx := api.NewDirectusAccessContext()
+	ctx.UseContinueOnError(true)
+	product := loadProduct(t, api, ctx, productId)
+	product.Name = "Fast proxy"
+	server.setFail("POST /items/location", true)
+	ctx.Add(&Location{Code: "de"})
+	server.sent()
+
+	_, err := ctx.SaveChangesWithReport()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !ctx.IsTracked(product) {
+		t.Fatal("saved object is not tracked after partial failure")
+	}
+	if changes := ctx.ChangeSet()["product"]; changes != nil && len(changes.Patches) != 0 {
+		t.Errorf("saved product still has pending changes: %v", changes.Patches)
+	}
+}
@@ -0,0 +1,59 @@
+package directus
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Client side helpers for presenting Search results
+
+func searchTokens(term string) []string {
+	return strings.Fields(strings.ToLower(term))
+}
+
+// SearchScore rates how well values match search term, from 0 (no match) to 1 (every word equals some value).
+// Words of term starting a value are rated higher than words found in the middle of it
+func SearchScore(term string, values ...string) float64 {
+	tokens := searchTokens(term)
+	if len(tokens) == 0 {
+		return 0
+	}
+	score := 0
+	for _, token := range tokens {
+		best := 0
+		for _, v := range values {
+			v = strings.ToLower(v)
+			switch {
+			case v == token:
+				best = 3
+			case strings.HasPrefix(v, token) && best < 2:
+				best = 2
+			case strings.Contains(v, token) && best < 1:
+				best = 1
+			}
+		}
+		score += best
+	}
+	return float64(score) / float64(3*len(tokens))
+}
+
+// Highlight wraps every case-insensitive occurrence of search term words in text with open and close markers
+func Highlight(text, term, open, close string) string {
+	tokens := searchTokens(term)
+	if len(tokens) == 0 {
+		return text
+	}
+	// Alternation matches leftmost-first, longer words go first so they win over their prefixes
+	sort.SliceStable(tokens, func(i, j int) bool {
+		return len(tokens[i]) > len(tokens[j])
+	})
+	for i, token := range tokens {
+		tokens[i] = regexp.QuoteMeta(token)
+	}
+	re := regexp.MustCompile("(?i)(" + strings.Join(tokens, "|") + ")")
+	// Markers are inserted literally, they may contain "$"
+	return re.ReplaceAllStringFunc(text, func(match string) string {
+		return open + match + close
+	})
+}
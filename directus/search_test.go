@@ -0,0 +1,21 @@
+package directus
+
+import "testing"
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		text, term, open, close string
+		want                    string
+	}{
+		{"abc", "a ab", "[", "]", "[ab]c"},
+		{"Fast Proxy", "proxy", "<b>", "</b>", "Fast <b>Proxy</b>"},
+		{"price 10", "price", "$1<", ">", "$1<price> 10"},
+		{"a.b", ".", "[", "]", "a[.]b"},
+		{"text", "  ", "[", "]", "text"},
+	}
+	for _, tt := range tests {
+		if got := Highlight(tt.text, tt.term, tt.open, tt.close); got != tt.want {
+			t.Errorf("Highlight(%q, %q) = %q, want %q", tt.text, tt.term, got, tt.want)
+		}
+	}
+}
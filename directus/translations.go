@@ -0,0 +1,239 @@
+package directus
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Default field of translations junction holding language code
+const TRANSLATIONS_LANGUAGE_FIELD = "languages_code"
+
+// Row of *_translations junction collection
+type ITranslation interface {
+	IDirectusObject
+	Language() string
+}
+
+// Field saved through directus relational payload of its parent object, like translations.
+// Parent objects pick such fields up by reflection, so generated Map and Diff do not need to know them
+type IRelationalField interface {
+	// Payload of changes against old value of the same type, nil when nothing changed
+	RelationalDiff(old any) any
+	// Payload creating related items together with parent, nil when there are none
+	RelationalCreate() any
+}
+
+// Value of translations field, one row per language, e.g. Translations[ProductTranslations, *ProductTranslations].
+// Rows are saved through relational payload of parent object, so they are not tracked on their own
+type Translations[V any, T interface {
+	*V
+	ITranslation
+}] struct {
+	Items []T
+}
+
+// Get returns translation to language, e.g. product.Translations.Get("de")
+func (h Translations[V, T]) Get(language string) (T, bool) {
+	for _, item := range h.Items {
+		if item.Language() == language {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// Set replaces translation with the same language or adds new one
+func (h *Translations[V, T]) Set(translation T) {
+	for i, item := range h.Items {
+		if item.Language() == translation.Language() {
+			h.Items[i] = translation
+			return
+		}
+	}
+	h.Items = append(h.Items, translation)
+}
+
+func (h *Translations[V, T]) Remove(language string) {
+	items := make([]T, 0, len(h.Items))
+	for _, item := range h.Items {
+		if item.Language() != language {
+			items = append(items, item)
+		}
+	}
+	h.Items = items
+}
+
+func (h Translations[V, T]) Languages() []string {
+	languages := make([]string, 0, len(h.Items))
+	for _, item := range h.Items {
+		languages = append(languages, item.Language())
+	}
+	return languages
+}
+
+func (h Translations[V, T]) DeepCopy() Translations[V, T] {
+	if h.Items == nil {
+		return h
+	}
+	copied := Translations[V, T]{Items: make([]T, 0, len(h.Items))}
+	for _, item := range h.Items {
+		copied.Items = append(copied.Items, item.DeepCopy().(T))
+	}
+	return copied
+}
+
+func (h Translations[V, T]) Equal(other Translations[V, T]) bool {
+	return h.Diff(other) == nil
+}
+
+// Diff returns directus relational payload with created, updated and deleted rows, nil when nothing changed
+func (h Translations[V, T]) Diff(old Translations[V, T]) map[string]any {
+	oldItems := map[string]T{}
+	for _, item := range old.Items {
+		// Rows not created yet have nothing to update or delete
+		if !isZeroKey(item.GetId()) {
+			oldItems[item.GetId()] = item
+		}
+	}
+	create := []map[string]any{}
+	update := []map[string]any{}
+	for _, item := range h.Items {
+		oldItem, exists := oldItems[item.GetId()]
+		if !exists {
+			create = append(create, insertPayload(item))
+			continue
+		}
+		delete(oldItems, item.GetId())
+		diff := item.Diff(oldItem)
+		if diff != nil && len(dropUnset(diff)) != 0 {
+			diff["id"] = item.GetId()
+			update = append(update, diff)
+		}
+	}
+	remove := []string{}
+	for id := range oldItems {
+		remove = append(remove, id)
+	}
+	if len(create) == 0 && len(update) == 0 && len(remove) == 0 {
+		return nil
+	}
+	return map[string]any{"create": create, "update": update, "delete": remove}
+}
+
+func (h Translations[V, T]) RelationalDiff(old any) any {
+	previous, _ := old.(Translations[V, T])
+	diff := h.Diff(previous)
+	if diff == nil {
+		return nil
+	}
+	return diff
+}
+
+func (h Translations[V, T]) RelationalCreate() any {
+	if len(h.Items) == 0 {
+		return nil
+	}
+	rows := make([]map[string]any, 0, len(h.Items))
+	for _, item := range h.Items {
+		rows = append(rows, insertPayload(item))
+	}
+	return rows
+}
+
+// Rows are sent as payloads, so relations are keys and generated embedded fields are left out
+func (h Translations[V, T]) MarshalJSON() ([]byte, error) {
+	rows := make([]map[string]any, 0, len(h.Items))
+	for _, item := range h.Items {
+		rows = append(rows, objectPayload(item))
+	}
+	return json.Marshal(rows)
+}
+
+func (h *Translations[V, T]) UnmarshalJSON(data []byte) error {
+	h.Items = nil
+	return json.Unmarshal(data, &h.Items)
+}
+
+// Relational fields of object by json name
+func relationalFields(obj IDirectusObject) map[string]IRelationalField {
+	result := map[string]IRelationalField{}
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return result
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Anonymous || !f.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		if field, ok := v.Field(i).Interface().(IRelationalField); ok {
+			result[name] = field
+		}
+	}
+	return result
+}
+
+// Related objects of obj like its Track, extended by objects held in relational fields, e.g. items of M2AList
+func trackedObjects(obj IDirectusObject) []IDirectusObject {
+	objects := obj.Track()
+	seen := map[IDirectusObject]bool{obj: true}
+	for _, related := range objects {
+		seen[related] = true
+	}
+	pending := append([]IDirectusObject{obj}, objects...)
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		for _, field := range relationalFields(current) {
+			tracked, ok := field.(interface{ Track() []IDirectusObject })
+			if !ok {
+				continue
+			}
+			for _, related := range tracked.Track() {
+				if !seen[related] {
+					seen[related] = true
+					objects = append(objects, related)
+					pending = append(pending, related)
+				}
+			}
+		}
+	}
+	return objects
+}
+
+// Relational payloads of fields changed between old and actual state of object
+func relationalDiff(actual, old IDirectusObject) map[string]any {
+	oldFields := relationalFields(old)
+	diff := map[string]any{}
+	for name, field := range relationalFields(actual) {
+		if change := field.RelationalDiff(oldFields[name]); change != nil {
+			diff[name] = change
+		}
+	}
+	return diff
+}
+
+// WithTranslations fetches translations field limited to languages, all languages are fetched when none is given
+func (h *CollectionQuery[K, V]) WithTranslations(field string, language ...string) *CollectionQuery[K, V] {
+	field = strings.ReplaceAll(field, " ", "")
+	h.Include(field + ".*")
+	if len(language) == 0 {
+		return h
+	}
+	query, exists := h.deepQueries[field]
+	if !exists {
+		query = NewDeepQuery()
+		h.Deep(field, query)
+	}
+	values := make([]any, len(language))
+	for i, l := range language {
+		values[i] = l
+	}
+	query.whereIn(TRANSLATIONS_LANGUAGE_FIELD, values...)
+	return h
+}
@@ -17,6 +17,10 @@ type IDirectusObject interface {
 	Map() map[string]interface{}
 }
 
+type IDirectusEnum interface {
+	IsValid() bool
+}
+
 type DirectusActivity struct {
 	IDirectusObject
 	Action     string              `json:"action"`
@@ -517,46 +521,46 @@ func (cf DirectusExtensions) CollectionName() string {
 
 type DirectusFields struct {
 	IDirectusObject
-	Conditions        any             `json:"conditions"`
-	Display           *string         `json:"display"`
-	DisplayOptions    any             `json:"display_options"`
-	Field             string          `json:"field"`
-	Group             *DirectusFields `json:"group"`
-	Hidden            bool            `json:"hidden"`
-	Id                int             `json:"id"`
-	Interface         *string         `json:"interface"`
-	Note              *string         `json:"note"`
-	Options           any             `json:"options"`
-	Readonly          bool            `json:"readonly"`
-	Required          *bool           `json:"required"`
-	Sort              *int            `json:"sort"`
-	Special           any             `json:"special"`
-	Translations      any             `json:"translations"`
-	Validation        any             `json:"validation"`
-	ValidationMessage *string         `json:"validation_message"`
-	Width             *string         `json:"width"`
+	Conditions        JSON[[]map[string]any] `json:"conditions"`
+	Display           *string                `json:"display"`
+	DisplayOptions    JSON[map[string]any]   `json:"display_options"`
+	Field             string                 `json:"field"`
+	Group             *DirectusFields        `json:"group"`
+	Hidden            bool                   `json:"hidden"`
+	Id                int                    `json:"id"`
+	Interface         *string                `json:"interface"`
+	Note              *string                `json:"note"`
+	Options           JSON[map[string]any]   `json:"options"`
+	Readonly          bool                   `json:"readonly"`
+	Required          *bool                  `json:"required"`
+	Sort              *int                   `json:"sort"`
+	Special           JSON[[]string]         `json:"special"`
+	Translations      JSON[[]map[string]any] `json:"translations"`
+	Validation        JSON[map[string]any]   `json:"validation"`
+	ValidationMessage *string                `json:"validation_message"`
+	Width             *string                `json:"width"`
 }
 
 func (cf *DirectusFields) UnmarshalJSON(data []byte) error {
 	type directusfields_internal struct {
-		Conditions        any             `json:"conditions"`
-		Display           *string         `json:"display"`
-		DisplayOptions    any             `json:"display_options"`
-		Field             string          `json:"field"`
-		Group             *DirectusFields `json:"group"`
-		Hidden            bool            `json:"hidden"`
-		Id                int             `json:"id"`
-		Interface         *string         `json:"interface"`
-		Note              *string         `json:"note"`
-		Options           any             `json:"options"`
-		Readonly          bool            `json:"readonly"`
-		Required          *bool           `json:"required"`
-		Sort              *int            `json:"sort"`
-		Special           any             `json:"special"`
-		Translations      any             `json:"translations"`
-		Validation        any             `json:"validation"`
-		ValidationMessage *string         `json:"validation_message"`
-		Width             *string         `json:"width"`
+		Conditions        JSON[[]map[string]any] `json:"conditions"`
+		Display           *string                `json:"display"`
+		DisplayOptions    JSON[map[string]any]   `json:"display_options"`
+		Field             string                 `json:"field"`
+		Group             *DirectusFields        `json:"group"`
+		Hidden            bool                   `json:"hidden"`
+		Id                int                    `json:"id"`
+		Interface         *string                `json:"interface"`
+		Note              *string                `json:"note"`
+		Options           JSON[map[string]any]   `json:"options"`
+		Readonly          bool                   `json:"readonly"`
+		Required          *bool                  `json:"required"`
+		Sort              *int                   `json:"sort"`
+		Special           JSON[[]string]         `json:"special"`
+		Translations      JSON[[]map[string]any] `json:"translations"`
+		Validation        JSON[map[string]any]   `json:"validation"`
+		ValidationMessage *string                `json:"validation_message"`
+		Width             *string                `json:"width"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -592,13 +596,13 @@ func (cf *DirectusFields) UnmarshalJSON(data []byte) error {
 }
 func (cf DirectusFields) DeepCopy() IDirectusObject {
 	new_obj := &DirectusFields{}
-	new_obj.Conditions = cf.Conditions
+	new_obj.Conditions = cf.Conditions.DeepCopy()
 	if cf.Display != nil {
 		temp := ""
 		new_obj.Display = &temp
 		*new_obj.Display = *cf.Display
 	}
-	new_obj.DisplayOptions = cf.DisplayOptions
+	new_obj.DisplayOptions = cf.DisplayOptions.DeepCopy()
 	new_obj.Field = cf.Field
 	if cf.Group != nil {
 		new_obj.Group = (*cf.Group).DeepCopy().(*DirectusFields)
@@ -615,7 +619,7 @@ func (cf DirectusFields) DeepCopy() IDirectusObject {
 		new_obj.Note = &temp
 		*new_obj.Note = *cf.Note
 	}
-	new_obj.Options = cf.Options
+	new_obj.Options = cf.Options.DeepCopy()
 	new_obj.Readonly = cf.Readonly
 	if cf.Required != nil {
 		temp := false
@@ -627,9 +631,9 @@ func (cf DirectusFields) DeepCopy() IDirectusObject {
 		new_obj.Sort = &temp
 		*new_obj.Sort = *cf.Sort
 	}
-	new_obj.Special = cf.Special
-	new_obj.Translations = cf.Translations
-	new_obj.Validation = cf.Validation
+	new_obj.Special = cf.Special.DeepCopy()
+	new_obj.Translations = cf.Translations.DeepCopy()
+	new_obj.Validation = cf.Validation.DeepCopy()
 	if cf.ValidationMessage != nil {
 		temp := ""
 		new_obj.ValidationMessage = &temp
@@ -645,7 +649,7 @@ func (cf DirectusFields) DeepCopy() IDirectusObject {
 func (cf DirectusFields) Diff(old IDirectusObject) map[string]interface{} {
 	diff := make(map[string]interface{})
 
-	if cf.Conditions != old.(*DirectusFields).Conditions {
+	if !cf.Conditions.Equal(old.(*DirectusFields).Conditions) {
 		diff["conditions"] = cf.Conditions
 	}
 	if cf.Display == nil {
@@ -662,7 +666,7 @@ func (cf DirectusFields) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.DisplayOptions != old.(*DirectusFields).DisplayOptions {
+	if !cf.DisplayOptions.Equal(old.(*DirectusFields).DisplayOptions) {
 		diff["display_options"] = cf.DisplayOptions
 	}
 
@@ -704,7 +708,7 @@ func (cf DirectusFields) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.Options != old.(*DirectusFields).Options {
+	if !cf.Options.Equal(old.(*DirectusFields).Options) {
 		diff["options"] = cf.Options
 	}
 
@@ -738,15 +742,15 @@ func (cf DirectusFields) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.Special != old.(*DirectusFields).Special {
+	if !cf.Special.Equal(old.(*DirectusFields).Special) {
 		diff["special"] = cf.Special
 	}
 
-	if cf.Translations != old.(*DirectusFields).Translations {
+	if !cf.Translations.Equal(old.(*DirectusFields).Translations) {
 		diff["translations"] = cf.Translations
 	}
 
-	if cf.Validation != old.(*DirectusFields).Validation {
+	if !cf.Validation.Equal(old.(*DirectusFields).Validation) {
 		diff["validation"] = cf.Validation
 	}
 	if cf.ValidationMessage == nil {
@@ -827,60 +831,60 @@ func (cf DirectusFields) CollectionName() string {
 
 type DirectusFiles struct {
 	IDirectusObject
-	Charset           *string          `json:"charset"`
-	Description       *string          `json:"description"`
-	Duration          *int             `json:"duration"`
-	Embed             *string          `json:"embed"`
-	FilenameDisk      *string          `json:"filename_disk"`
-	FilenameDownload  string           `json:"filename_download"`
-	Filesize          *string          `json:"filesize"`
-	FocalPointDivider any              `json:"focal_point_divider"`
-	FocalPointX       *int             `json:"focal_point_x"`
-	FocalPointY       *int             `json:"focal_point_y"`
-	Folder            *DirectusFolders `json:"folder"`
-	Height            *int             `json:"height"`
-	Id                uuid.UUID        `json:"id"`
-	Location          *string          `json:"location"`
-	Metadata          any              `json:"metadata"`
-	ModifiedBy        *DirectusUsers   `json:"modified_by"`
-	ModifiedOn        time.Time        `json:"modified_on"`
-	Storage           string           `json:"storage"`
-	StorageDivider    any              `json:"storage_divider"`
-	Tags              any              `json:"tags"`
-	Title             *string          `json:"title"`
-	Type              *string          `json:"type"`
-	UploadedBy        *DirectusUsers   `json:"uploaded_by"`
-	UploadedOn        time.Time        `json:"uploaded_on"`
-	Width             *int             `json:"width"`
+	Charset           *string              `json:"charset"`
+	Description       *string              `json:"description"`
+	Duration          *int                 `json:"duration"`
+	Embed             *string              `json:"embed"`
+	FilenameDisk      *string              `json:"filename_disk"`
+	FilenameDownload  string               `json:"filename_download"`
+	Filesize          *string              `json:"filesize"`
+	FocalPointDivider any                  `json:"focal_point_divider"`
+	FocalPointX       *int                 `json:"focal_point_x"`
+	FocalPointY       *int                 `json:"focal_point_y"`
+	Folder            *DirectusFolders     `json:"folder"`
+	Height            *int                 `json:"height"`
+	Id                uuid.UUID            `json:"id"`
+	Location          *string              `json:"location"`
+	Metadata          JSON[map[string]any] `json:"metadata"`
+	ModifiedBy        *DirectusUsers       `json:"modified_by"`
+	ModifiedOn        time.Time            `json:"modified_on"`
+	Storage           string               `json:"storage"`
+	StorageDivider    any                  `json:"storage_divider"`
+	Tags              JSON[[]string]       `json:"tags"`
+	Title             *string              `json:"title"`
+	Type              *string              `json:"type"`
+	UploadedBy        *DirectusUsers       `json:"uploaded_by"`
+	UploadedOn        time.Time            `json:"uploaded_on"`
+	Width             *int                 `json:"width"`
 }
 
 func (cf *DirectusFiles) UnmarshalJSON(data []byte) error {
 	type directusfiles_internal struct {
-		Charset           *string          `json:"charset"`
-		Description       *string          `json:"description"`
-		Duration          *int             `json:"duration"`
-		Embed             *string          `json:"embed"`
-		FilenameDisk      *string          `json:"filename_disk"`
-		FilenameDownload  string           `json:"filename_download"`
-		Filesize          *string          `json:"filesize"`
-		FocalPointDivider any              `json:"focal_point_divider"`
-		FocalPointX       *int             `json:"focal_point_x"`
-		FocalPointY       *int             `json:"focal_point_y"`
-		Folder            *DirectusFolders `json:"folder"`
-		Height            *int             `json:"height"`
-		Id                uuid.UUID        `json:"id"`
-		Location          *string          `json:"location"`
-		Metadata          any              `json:"metadata"`
-		ModifiedBy        *DirectusUsers   `json:"modified_by"`
-		ModifiedOn        time.Time        `json:"modified_on"`
-		Storage           string           `json:"storage"`
-		StorageDivider    any              `json:"storage_divider"`
-		Tags              any              `json:"tags"`
-		Title             *string          `json:"title"`
-		Type              *string          `json:"type"`
-		UploadedBy        *DirectusUsers   `json:"uploaded_by"`
-		UploadedOn        time.Time        `json:"uploaded_on"`
-		Width             *int             `json:"width"`
+		Charset           *string              `json:"charset"`
+		Description       *string              `json:"description"`
+		Duration          *int                 `json:"duration"`
+		Embed             *string              `json:"embed"`
+		FilenameDisk      *string              `json:"filename_disk"`
+		FilenameDownload  string               `json:"filename_download"`
+		Filesize          *string              `json:"filesize"`
+		FocalPointDivider any                  `json:"focal_point_divider"`
+		FocalPointX       *int                 `json:"focal_point_x"`
+		FocalPointY       *int                 `json:"focal_point_y"`
+		Folder            *DirectusFolders     `json:"folder"`
+		Height            *int                 `json:"height"`
+		Id                uuid.UUID            `json:"id"`
+		Location          *string              `json:"location"`
+		Metadata          JSON[map[string]any] `json:"metadata"`
+		ModifiedBy        *DirectusUsers       `json:"modified_by"`
+		ModifiedOn        time.Time            `json:"modified_on"`
+		Storage           string               `json:"storage"`
+		StorageDivider    any                  `json:"storage_divider"`
+		Tags              JSON[[]string]       `json:"tags"`
+		Title             *string              `json:"title"`
+		Type              *string              `json:"type"`
+		UploadedBy        *DirectusUsers       `json:"uploaded_by"`
+		UploadedOn        time.Time            `json:"uploaded_on"`
+		Width             *int                 `json:"width"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -979,14 +983,14 @@ func (cf DirectusFiles) DeepCopy() IDirectusObject {
 		new_obj.Location = &temp
 		*new_obj.Location = *cf.Location
 	}
-	new_obj.Metadata = cf.Metadata
+	new_obj.Metadata = cf.Metadata.DeepCopy()
 	if cf.ModifiedBy != nil {
 		new_obj.ModifiedBy = (*cf.ModifiedBy).DeepCopy().(*DirectusUsers)
 	}
 	new_obj.ModifiedOn = cf.ModifiedOn
 	new_obj.Storage = cf.Storage
 	new_obj.StorageDivider = cf.StorageDivider
-	new_obj.Tags = cf.Tags
+	new_obj.Tags = cf.Tags.DeepCopy()
 	if cf.Title != nil {
 		temp := ""
 		new_obj.Title = &temp
@@ -1155,7 +1159,7 @@ func (cf DirectusFiles) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.Metadata != old.(*DirectusFiles).Metadata {
+	if !cf.Metadata.Equal(old.(*DirectusFiles).Metadata) {
 		diff["metadata"] = cf.Metadata
 	}
 
@@ -1171,7 +1175,7 @@ func (cf DirectusFiles) Diff(old IDirectusObject) map[string]interface{} {
 		diff["storage_divider"] = cf.StorageDivider
 	}
 
-	if cf.Tags != old.(*DirectusFiles).Tags {
+	if !cf.Tags.Equal(old.(*DirectusFiles).Tags) {
 		diff["tags"] = cf.Tags
 	}
 	if cf.Title == nil {
@@ -1284,6 +1288,21 @@ func (cf DirectusFiles) CollectionName() string {
 	return "directus_files"
 }
 
+type DirectusFlowsStatus string
+
+const (
+	DirectusFlowsStatusActive   = DirectusFlowsStatus("active")
+	DirectusFlowsStatusInactive = DirectusFlowsStatus("inactive")
+)
+
+func (e DirectusFlowsStatus) IsValid() bool {
+	switch e {
+	case DirectusFlowsStatusActive, DirectusFlowsStatusInactive:
+		return true
+	}
+	return false
+}
+
 type DirectusFlows struct {
 	IDirectusObject
 	Accountability *string              `json:"accountability"`
@@ -1295,8 +1314,8 @@ type DirectusFlows struct {
 	Name           string               `json:"name"`
 	Operation      *DirectusOperations  `json:"operation"`
 	Operations     []DirectusOperations `json:"operations"`
-	Options        any                  `json:"options"`
-	Status         string               `json:"status"`
+	Options        JSON[map[string]any] `json:"options"`
+	Status         DirectusFlowsStatus  `json:"status"`
 	Trigger        *string              `json:"trigger"`
 	UserCreated    *DirectusUsers       `json:"user_created"`
 }
@@ -1312,8 +1331,8 @@ func (cf *DirectusFlows) UnmarshalJSON(data []byte) error {
 		Name           string               `json:"name"`
 		Operation      *DirectusOperations  `json:"operation"`
 		Operations     []DirectusOperations `json:"operations"`
-		Options        any                  `json:"options"`
-		Status         string               `json:"status"`
+		Options        JSON[map[string]any] `json:"options"`
+		Status         DirectusFlowsStatus  `json:"status"`
 		Trigger        *string              `json:"trigger"`
 		UserCreated    *DirectusUsers       `json:"user_created"`
 	}
@@ -1380,7 +1399,7 @@ func (cf DirectusFlows) DeepCopy() IDirectusObject {
 		new_obj.Operations = make([]DirectusOperations, len(cf.Operations))
 		copy(new_obj.Operations, cf.Operations)
 	}
-	new_obj.Options = cf.Options
+	new_obj.Options = cf.Options.DeepCopy()
 	new_obj.Status = cf.Status
 	if cf.Trigger != nil {
 		temp := ""
@@ -1469,7 +1488,7 @@ func (cf DirectusFlows) Diff(old IDirectusObject) map[string]interface{} {
 		diff["name"] = cf.Name
 	}
 
-	if cf.Options != old.(*DirectusFlows).Options {
+	if !cf.Options.Equal(old.(*DirectusFlows).Options) {
 		diff["options"] = cf.Options
 	}
 
@@ -1623,30 +1642,45 @@ func (cf DirectusFolders) CollectionName() string {
 	return "directus_folders"
 }
 
+type DirectusNotificationsStatus string
+
+const (
+	DirectusNotificationsStatusInbox    = DirectusNotificationsStatus("inbox")
+	DirectusNotificationsStatusArchived = DirectusNotificationsStatus("archived")
+)
+
+func (e DirectusNotificationsStatus) IsValid() bool {
+	switch e {
+	case DirectusNotificationsStatusInbox, DirectusNotificationsStatusArchived:
+		return true
+	}
+	return false
+}
+
 type DirectusNotifications struct {
 	IDirectusObject
-	Collection *string        `json:"collection"`
-	Id         int            `json:"id"`
-	Item       *string        `json:"item"`
-	Message    *string        `json:"message"`
-	Recipient  *DirectusUsers `json:"recipient"`
-	Sender     *DirectusUsers `json:"sender"`
-	Status     *string        `json:"status"`
-	Subject    string         `json:"subject"`
-	Timestamp  *time.Time     `json:"timestamp"`
+	Collection *string                      `json:"collection"`
+	Id         int                          `json:"id"`
+	Item       *string                      `json:"item"`
+	Message    *string                      `json:"message"`
+	Recipient  *DirectusUsers               `json:"recipient"`
+	Sender     *DirectusUsers               `json:"sender"`
+	Status     *DirectusNotificationsStatus `json:"status"`
+	Subject    string                       `json:"subject"`
+	Timestamp  *time.Time                   `json:"timestamp"`
 }
 
 func (cf *DirectusNotifications) UnmarshalJSON(data []byte) error {
 	type directusnotifications_internal struct {
-		Collection *string        `json:"collection"`
-		Id         int            `json:"id"`
-		Item       *string        `json:"item"`
-		Message    *string        `json:"message"`
-		Recipient  *DirectusUsers `json:"recipient"`
-		Sender     *DirectusUsers `json:"sender"`
-		Status     *string        `json:"status"`
-		Subject    string         `json:"subject"`
-		Timestamp  *time.Time     `json:"timestamp"`
+		Collection *string                      `json:"collection"`
+		Id         int                          `json:"id"`
+		Item       *string                      `json:"item"`
+		Message    *string                      `json:"message"`
+		Recipient  *DirectusUsers               `json:"recipient"`
+		Sender     *DirectusUsers               `json:"sender"`
+		Status     *DirectusNotificationsStatus `json:"status"`
+		Subject    string                       `json:"subject"`
+		Timestamp  *time.Time                   `json:"timestamp"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -1696,7 +1730,7 @@ func (cf DirectusNotifications) DeepCopy() IDirectusObject {
 		new_obj.Sender = (*cf.Sender).DeepCopy().(*DirectusUsers)
 	}
 	if cf.Status != nil {
-		temp := ""
+		temp := DirectusNotificationsStatus("")
 		new_obj.Status = &temp
 		*new_obj.Status = *cf.Status
 	}
@@ -1831,34 +1865,34 @@ func (cf DirectusNotifications) CollectionName() string {
 
 type DirectusOperations struct {
 	IDirectusObject
-	DateCreated *time.Time          `json:"date_created"`
-	Flow        *DirectusFlows      `json:"flow"`
-	Id          uuid.UUID           `json:"id"`
-	Key         string              `json:"key"`
-	Name        *string             `json:"name"`
-	Options     any                 `json:"options"`
-	PositionX   int                 `json:"position_x"`
-	PositionY   int                 `json:"position_y"`
-	Reject      *DirectusOperations `json:"reject"`
-	Resolve     *DirectusOperations `json:"resolve"`
-	Type        string              `json:"type"`
-	UserCreated *DirectusUsers      `json:"user_created"`
+	DateCreated *time.Time           `json:"date_created"`
+	Flow        *DirectusFlows       `json:"flow"`
+	Id          uuid.UUID            `json:"id"`
+	Key         string               `json:"key"`
+	Name        *string              `json:"name"`
+	Options     JSON[map[string]any] `json:"options"`
+	PositionX   int                  `json:"position_x"`
+	PositionY   int                  `json:"position_y"`
+	Reject      *DirectusOperations  `json:"reject"`
+	Resolve     *DirectusOperations  `json:"resolve"`
+	Type        string               `json:"type"`
+	UserCreated *DirectusUsers       `json:"user_created"`
 }
 
 func (cf *DirectusOperations) UnmarshalJSON(data []byte) error {
 	type directusoperations_internal struct {
-		DateCreated *time.Time          `json:"date_created"`
-		Flow        *DirectusFlows      `json:"flow"`
-		Id          uuid.UUID           `json:"id"`
-		Key         string              `json:"key"`
-		Name        *string             `json:"name"`
-		Options     any                 `json:"options"`
-		PositionX   int                 `json:"position_x"`
-		PositionY   int                 `json:"position_y"`
-		Reject      *DirectusOperations `json:"reject"`
-		Resolve     *DirectusOperations `json:"resolve"`
-		Type        string              `json:"type"`
-		UserCreated *DirectusUsers      `json:"user_created"`
+		DateCreated *time.Time           `json:"date_created"`
+		Flow        *DirectusFlows       `json:"flow"`
+		Id          uuid.UUID            `json:"id"`
+		Key         string               `json:"key"`
+		Name        *string              `json:"name"`
+		Options     JSON[map[string]any] `json:"options"`
+		PositionX   int                  `json:"position_x"`
+		PositionY   int                  `json:"position_y"`
+		Reject      *DirectusOperations  `json:"reject"`
+		Resolve     *DirectusOperations  `json:"resolve"`
+		Type        string               `json:"type"`
+		UserCreated *DirectusUsers       `json:"user_created"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -1903,7 +1937,7 @@ func (cf DirectusOperations) DeepCopy() IDirectusObject {
 		new_obj.Name = &temp
 		*new_obj.Name = *cf.Name
 	}
-	new_obj.Options = cf.Options
+	new_obj.Options = cf.Options.DeepCopy()
 	new_obj.PositionX = cf.PositionX
 	new_obj.PositionY = cf.PositionY
 	if cf.Reject != nil {
@@ -1956,7 +1990,7 @@ func (cf DirectusOperations) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.Options != old.(*DirectusOperations).Options {
+	if !cf.Options.Equal(old.(*DirectusOperations).Options) {
 		diff["options"] = cf.Options
 	}
 
@@ -2028,40 +2062,40 @@ func (cf DirectusOperations) CollectionName() string {
 
 type DirectusPanels struct {
 	IDirectusObject
-	Color       *string             `json:"color"`
-	Dashboard   *DirectusDashboards `json:"dashboard"`
-	DateCreated *time.Time          `json:"date_created"`
-	Height      int                 `json:"height"`
-	Icon        *string             `json:"icon"`
-	Id          uuid.UUID           `json:"id"`
-	Name        *string             `json:"name"`
-	Note        *string             `json:"note"`
-	Options     any                 `json:"options"`
-	PositionX   int                 `json:"position_x"`
-	PositionY   int                 `json:"position_y"`
-	ShowHeader  bool                `json:"show_header"`
-	Type        string              `json:"type"`
-	UserCreated *DirectusUsers      `json:"user_created"`
-	Width       int                 `json:"width"`
+	Color       *string              `json:"color"`
+	Dashboard   *DirectusDashboards  `json:"dashboard"`
+	DateCreated *time.Time           `json:"date_created"`
+	Height      int                  `json:"height"`
+	Icon        *string              `json:"icon"`
+	Id          uuid.UUID            `json:"id"`
+	Name        *string              `json:"name"`
+	Note        *string              `json:"note"`
+	Options     JSON[map[string]any] `json:"options"`
+	PositionX   int                  `json:"position_x"`
+	PositionY   int                  `json:"position_y"`
+	ShowHeader  bool                 `json:"show_header"`
+	Type        string               `json:"type"`
+	UserCreated *DirectusUsers       `json:"user_created"`
+	Width       int                  `json:"width"`
 }
 
 func (cf *DirectusPanels) UnmarshalJSON(data []byte) error {
 	type directuspanels_internal struct {
-		Color       *string             `json:"color"`
-		Dashboard   *DirectusDashboards `json:"dashboard"`
-		DateCreated *time.Time          `json:"date_created"`
-		Height      int                 `json:"height"`
-		Icon        *string             `json:"icon"`
-		Id          uuid.UUID           `json:"id"`
-		Name        *string             `json:"name"`
-		Note        *string             `json:"note"`
-		Options     any                 `json:"options"`
-		PositionX   int                 `json:"position_x"`
-		PositionY   int                 `json:"position_y"`
-		ShowHeader  bool                `json:"show_header"`
-		Type        string              `json:"type"`
-		UserCreated *DirectusUsers      `json:"user_created"`
-		Width       int                 `json:"width"`
+		Color       *string              `json:"color"`
+		Dashboard   *DirectusDashboards  `json:"dashboard"`
+		DateCreated *time.Time           `json:"date_created"`
+		Height      int                  `json:"height"`
+		Icon        *string              `json:"icon"`
+		Id          uuid.UUID            `json:"id"`
+		Name        *string              `json:"name"`
+		Note        *string              `json:"note"`
+		Options     JSON[map[string]any] `json:"options"`
+		PositionX   int                  `json:"position_x"`
+		PositionY   int                  `json:"position_y"`
+		ShowHeader  bool                 `json:"show_header"`
+		Type        string               `json:"type"`
+		UserCreated *DirectusUsers       `json:"user_created"`
+		Width       int                  `json:"width"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -2124,7 +2158,7 @@ func (cf DirectusPanels) DeepCopy() IDirectusObject {
 		new_obj.Note = &temp
 		*new_obj.Note = *cf.Note
 	}
-	new_obj.Options = cf.Options
+	new_obj.Options = cf.Options.DeepCopy()
 	new_obj.PositionX = cf.PositionX
 	new_obj.PositionY = cf.PositionY
 	new_obj.ShowHeader = cf.ShowHeader
@@ -2213,7 +2247,7 @@ func (cf DirectusPanels) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.Options != old.(*DirectusPanels).Options {
+	if !cf.Options.Equal(old.(*DirectusPanels).Options) {
 		diff["options"] = cf.Options
 	}
 
@@ -2290,26 +2324,26 @@ func (cf DirectusPanels) CollectionName() string {
 
 type DirectusPermissions struct {
 	IDirectusObject
-	Action      string         `json:"action"`
-	Collection  string         `json:"collection"`
-	Fields      any            `json:"fields"`
-	Id          int            `json:"id"`
-	Permissions any            `json:"permissions"`
-	Presets     any            `json:"presets"`
-	Role        *DirectusRoles `json:"role"`
-	Validation  any            `json:"validation"`
+	Action      string               `json:"action"`
+	Collection  string               `json:"collection"`
+	Fields      JSON[[]string]       `json:"fields"`
+	Id          int                  `json:"id"`
+	Permissions JSON[map[string]any] `json:"permissions"`
+	Presets     JSON[map[string]any] `json:"presets"`
+	Role        *DirectusRoles       `json:"role"`
+	Validation  JSON[map[string]any] `json:"validation"`
 }
 
 func (cf *DirectusPermissions) UnmarshalJSON(data []byte) error {
 	type directuspermissions_internal struct {
-		Action      string         `json:"action"`
-		Collection  string         `json:"collection"`
-		Fields      any            `json:"fields"`
-		Id          int            `json:"id"`
-		Permissions any            `json:"permissions"`
-		Presets     any            `json:"presets"`
-		Role        *DirectusRoles `json:"role"`
-		Validation  any            `json:"validation"`
+		Action      string               `json:"action"`
+		Collection  string               `json:"collection"`
+		Fields      JSON[[]string]       `json:"fields"`
+		Id          int                  `json:"id"`
+		Permissions JSON[map[string]any] `json:"permissions"`
+		Presets     JSON[map[string]any] `json:"presets"`
+		Role        *DirectusRoles       `json:"role"`
+		Validation  JSON[map[string]any] `json:"validation"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -2337,14 +2371,14 @@ func (cf DirectusPermissions) DeepCopy() IDirectusObject {
 	new_obj := &DirectusPermissions{}
 	new_obj.Action = cf.Action
 	new_obj.Collection = cf.Collection
-	new_obj.Fields = cf.Fields
+	new_obj.Fields = cf.Fields.DeepCopy()
 	new_obj.Id = cf.Id
-	new_obj.Permissions = cf.Permissions
-	new_obj.Presets = cf.Presets
+	new_obj.Permissions = cf.Permissions.DeepCopy()
+	new_obj.Presets = cf.Presets.DeepCopy()
 	if cf.Role != nil {
 		new_obj.Role = (*cf.Role).DeepCopy().(*DirectusRoles)
 	}
-	new_obj.Validation = cf.Validation
+	new_obj.Validation = cf.Validation.DeepCopy()
 	return new_obj
 }
 func (cf DirectusPermissions) Diff(old IDirectusObject) map[string]interface{} {
@@ -2358,7 +2392,7 @@ func (cf DirectusPermissions) Diff(old IDirectusObject) map[string]interface{} {
 		diff["collection"] = cf.Collection
 	}
 
-	if cf.Fields != old.(*DirectusPermissions).Fields {
+	if !cf.Fields.Equal(old.(*DirectusPermissions).Fields) {
 		diff["fields"] = cf.Fields
 	}
 
@@ -2366,15 +2400,15 @@ func (cf DirectusPermissions) Diff(old IDirectusObject) map[string]interface{} {
 		diff["id"] = cf.Id
 	}
 
-	if cf.Permissions != old.(*DirectusPermissions).Permissions {
+	if !cf.Permissions.Equal(old.(*DirectusPermissions).Permissions) {
 		diff["permissions"] = cf.Permissions
 	}
 
-	if cf.Presets != old.(*DirectusPermissions).Presets {
+	if !cf.Presets.Equal(old.(*DirectusPermissions).Presets) {
 		diff["presets"] = cf.Presets
 	}
 
-	if cf.Validation != old.(*DirectusPermissions).Validation {
+	if !cf.Validation.Equal(old.(*DirectusPermissions).Validation) {
 		diff["validation"] = cf.Validation
 	}
 
@@ -2419,36 +2453,36 @@ func (cf DirectusPermissions) CollectionName() string {
 
 type DirectusPresets struct {
 	IDirectusObject
-	Bookmark        *string        `json:"bookmark"`
-	Collection      *string        `json:"collection"`
-	Color           *string        `json:"color"`
-	Filter          any            `json:"filter"`
-	Icon            *string        `json:"icon"`
-	Id              int            `json:"id"`
-	Layout          *string        `json:"layout"`
-	LayoutOptions   any            `json:"layout_options"`
-	LayoutQuery     any            `json:"layout_query"`
-	RefreshInterval *int           `json:"refresh_interval"`
-	Role            *DirectusRoles `json:"role"`
-	Search          *string        `json:"search"`
-	User            *DirectusUsers `json:"user"`
+	Bookmark        *string              `json:"bookmark"`
+	Collection      *string              `json:"collection"`
+	Color           *string              `json:"color"`
+	Filter          JSON[map[string]any] `json:"filter"`
+	Icon            *string              `json:"icon"`
+	Id              int                  `json:"id"`
+	Layout          *string              `json:"layout"`
+	LayoutOptions   JSON[map[string]any] `json:"layout_options"`
+	LayoutQuery     JSON[map[string]any] `json:"layout_query"`
+	RefreshInterval *int                 `json:"refresh_interval"`
+	Role            *DirectusRoles       `json:"role"`
+	Search          *string              `json:"search"`
+	User            *DirectusUsers       `json:"user"`
 }
 
 func (cf *DirectusPresets) UnmarshalJSON(data []byte) error {
 	type directuspresets_internal struct {
-		Bookmark        *string        `json:"bookmark"`
-		Collection      *string        `json:"collection"`
-		Color           *string        `json:"color"`
-		Filter          any            `json:"filter"`
-		Icon            *string        `json:"icon"`
-		Id              int            `json:"id"`
-		Layout          *string        `json:"layout"`
-		LayoutOptions   any            `json:"layout_options"`
-		LayoutQuery     any            `json:"layout_query"`
-		RefreshInterval *int           `json:"refresh_interval"`
-		Role            *DirectusRoles `json:"role"`
-		Search          *string        `json:"search"`
-		User            *DirectusUsers `json:"user"`
+		Bookmark        *string              `json:"bookmark"`
+		Collection      *string              `json:"collection"`
+		Color           *string              `json:"color"`
+		Filter          JSON[map[string]any] `json:"filter"`
+		Icon            *string              `json:"icon"`
+		Id              int                  `json:"id"`
+		Layout          *string              `json:"layout"`
+		LayoutOptions   JSON[map[string]any] `json:"layout_options"`
+		LayoutQuery     JSON[map[string]any] `json:"layout_query"`
+		RefreshInterval *int                 `json:"refresh_interval"`
+		Role            *DirectusRoles       `json:"role"`
+		Search          *string              `json:"search"`
+		User            *DirectusUsers       `json:"user"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -2494,7 +2528,7 @@ func (cf DirectusPresets) DeepCopy() IDirectusObject {
 		new_obj.Color = &temp
 		*new_obj.Color = *cf.Color
 	}
-	new_obj.Filter = cf.Filter
+	new_obj.Filter = cf.Filter.DeepCopy()
 	if cf.Icon != nil {
 		temp := ""
 		new_obj.Icon = &temp
@@ -2506,8 +2540,8 @@ func (cf DirectusPresets) DeepCopy() IDirectusObject {
 		new_obj.Layout = &temp
 		*new_obj.Layout = *cf.Layout
 	}
-	new_obj.LayoutOptions = cf.LayoutOptions
-	new_obj.LayoutQuery = cf.LayoutQuery
+	new_obj.LayoutOptions = cf.LayoutOptions.DeepCopy()
+	new_obj.LayoutQuery = cf.LayoutQuery.DeepCopy()
 	if cf.RefreshInterval != nil {
 		temp := 0
 		new_obj.RefreshInterval = &temp
@@ -2569,7 +2603,7 @@ func (cf DirectusPresets) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.Filter != old.(*DirectusPresets).Filter {
+	if !cf.Filter.Equal(old.(*DirectusPresets).Filter) {
 		diff["filter"] = cf.Filter
 	}
 	if cf.Icon == nil {
@@ -2603,11 +2637,11 @@ func (cf DirectusPresets) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.LayoutOptions != old.(*DirectusPresets).LayoutOptions {
+	if !cf.LayoutOptions.Equal(old.(*DirectusPresets).LayoutOptions) {
 		diff["layout_options"] = cf.LayoutOptions
 	}
 
-	if cf.LayoutQuery != old.(*DirectusPresets).LayoutQuery {
+	if !cf.LayoutQuery.Equal(old.(*DirectusPresets).LayoutQuery) {
 		diff["layout_query"] = cf.LayoutQuery
 	}
 	if cf.RefreshInterval == nil {
@@ -2687,30 +2721,30 @@ func (cf DirectusPresets) CollectionName() string {
 
 type DirectusRelations struct {
 	IDirectusObject
-	Id                    int     `json:"id"`
-	JunctionField         *string `json:"junction_field"`
-	ManyCollection        string  `json:"many_collection"`
-	ManyField             string  `json:"many_field"`
-	OneAllowedCollections any     `json:"one_allowed_collections"`
-	OneCollection         *string `json:"one_collection"`
-	OneCollectionField    *string `json:"one_collection_field"`
-	OneDeselectAction     string  `json:"one_deselect_action"`
-	OneField              *string `json:"one_field"`
-	SortField             *string `json:"sort_field"`
+	Id                    int            `json:"id"`
+	JunctionField         *string        `json:"junction_field"`
+	ManyCollection        string         `json:"many_collection"`
+	ManyField             string         `json:"many_field"`
+	OneAllowedCollections JSON[[]string] `json:"one_allowed_collections"`
+	OneCollection         *string        `json:"one_collection"`
+	OneCollectionField    *string        `json:"one_collection_field"`
+	OneDeselectAction     string         `json:"one_deselect_action"`
+	OneField              *string        `json:"one_field"`
+	SortField             *string        `json:"sort_field"`
 }
 
 func (cf *DirectusRelations) UnmarshalJSON(data []byte) error {
 	type directusrelations_internal struct {
-		Id                    int     `json:"id"`
-		JunctionField         *string `json:"junction_field"`
-		ManyCollection        string  `json:"many_collection"`
-		ManyField             string  `json:"many_field"`
-		OneAllowedCollections any     `json:"one_allowed_collections"`
-		OneCollection         *string `json:"one_collection"`
-		OneCollectionField    *string `json:"one_collection_field"`
-		OneDeselectAction     string  `json:"one_deselect_action"`
-		OneField              *string `json:"one_field"`
-		SortField             *string `json:"sort_field"`
+		Id                    int            `json:"id"`
+		JunctionField         *string        `json:"junction_field"`
+		ManyCollection        string         `json:"many_collection"`
+		ManyField             string         `json:"many_field"`
+		OneAllowedCollections JSON[[]string] `json:"one_allowed_collections"`
+		OneCollection         *string        `json:"one_collection"`
+		OneCollectionField    *string        `json:"one_collection_field"`
+		OneDeselectAction     string         `json:"one_deselect_action"`
+		OneField              *string        `json:"one_field"`
+		SortField             *string        `json:"sort_field"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -2746,7 +2780,7 @@ func (cf DirectusRelations) DeepCopy() IDirectusObject {
 	}
 	new_obj.ManyCollection = cf.ManyCollection
 	new_obj.ManyField = cf.ManyField
-	new_obj.OneAllowedCollections = cf.OneAllowedCollections
+	new_obj.OneAllowedCollections = cf.OneAllowedCollections.DeepCopy()
 	if cf.OneCollection != nil {
 		temp := ""
 		new_obj.OneCollection = &temp
@@ -2798,7 +2832,7 @@ func (cf DirectusRelations) Diff(old IDirectusObject) map[string]interface{} {
 		diff["many_field"] = cf.ManyField
 	}
 
-	if cf.OneAllowedCollections != old.(*DirectusRelations).OneAllowedCollections {
+	if !cf.OneAllowedCollections.Equal(old.(*DirectusRelations).OneAllowedCollections) {
 		diff["one_allowed_collections"] = cf.OneAllowedCollections
 	}
 	if cf.OneCollection == nil {
@@ -2896,26 +2930,26 @@ func (cf DirectusRelations) CollectionName() string {
 
 type DirectusRevisions struct {
 	IDirectusObject
-	Activity   *DirectusActivity  `json:"activity"`
-	Collection string             `json:"collection"`
-	Data       any                `json:"data"`
-	Delta      any                `json:"delta"`
-	Id         int                `json:"id"`
-	Item       string             `json:"item"`
-	Parent     *DirectusRevisions `json:"parent"`
-	Version    *DirectusVersions  `json:"version"`
+	Activity   *DirectusActivity    `json:"activity"`
+	Collection string               `json:"collection"`
+	Data       JSON[map[string]any] `json:"data"`
+	Delta      JSON[map[string]any] `json:"delta"`
+	Id         int                  `json:"id"`
+	Item       string               `json:"item"`
+	Parent     *DirectusRevisions   `json:"parent"`
+	Version    *DirectusVersions    `json:"version"`
 }
 
 func (cf *DirectusRevisions) UnmarshalJSON(data []byte) error {
 	type directusrevisions_internal struct {
-		Activity   *DirectusActivity  `json:"activity"`
-		Collection string             `json:"collection"`
-		Data       any                `json:"data"`
-		Delta      any                `json:"delta"`
-		Id         int                `json:"id"`
-		Item       string             `json:"item"`
-		Parent     *DirectusRevisions `json:"parent"`
-		Version    *DirectusVersions  `json:"version"`
+		Activity   *DirectusActivity    `json:"activity"`
+		Collection string               `json:"collection"`
+		Data       JSON[map[string]any] `json:"data"`
+		Delta      JSON[map[string]any] `json:"delta"`
+		Id         int                  `json:"id"`
+		Item       string               `json:"item"`
+		Parent     *DirectusRevisions   `json:"parent"`
+		Version    *DirectusVersions    `json:"version"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -2945,8 +2979,8 @@ func (cf DirectusRevisions) DeepCopy() IDirectusObject {
 		new_obj.Activity = (*cf.Activity).DeepCopy().(*DirectusActivity)
 	}
 	new_obj.Collection = cf.Collection
-	new_obj.Data = cf.Data
-	new_obj.Delta = cf.Delta
+	new_obj.Data = cf.Data.DeepCopy()
+	new_obj.Delta = cf.Delta.DeepCopy()
 	new_obj.Id = cf.Id
 	new_obj.Item = cf.Item
 	if cf.Parent != nil {
@@ -2964,11 +2998,11 @@ func (cf DirectusRevisions) Diff(old IDirectusObject) map[string]interface{} {
 		diff["collection"] = cf.Collection
 	}
 
-	if cf.Data != old.(*DirectusRevisions).Data {
+	if !cf.Data.Equal(old.(*DirectusRevisions).Data) {
 		diff["data"] = cf.Data
 	}
 
-	if cf.Delta != old.(*DirectusRevisions).Delta {
+	if !cf.Delta.Equal(old.(*DirectusRevisions).Delta) {
 		diff["delta"] = cf.Delta
 	}
 
@@ -3032,7 +3066,7 @@ type DirectusRoles struct {
 	EnforceTfa  bool            `json:"enforce_tfa"`
 	Icon        string          `json:"icon"`
 	Id          uuid.UUID       `json:"id"`
-	IpAccess    any             `json:"ip_access"`
+	IpAccess    JSON[[]string]  `json:"ip_access"`
 	Name        string          `json:"name"`
 	Users       []DirectusUsers `json:"users"`
 }
@@ -3045,7 +3079,7 @@ func (cf *DirectusRoles) UnmarshalJSON(data []byte) error {
 		EnforceTfa  bool            `json:"enforce_tfa"`
 		Icon        string          `json:"icon"`
 		Id          uuid.UUID       `json:"id"`
-		IpAccess    any             `json:"ip_access"`
+		IpAccess    JSON[[]string]  `json:"ip_access"`
 		Name        string          `json:"name"`
 		Users       []DirectusUsers `json:"users"`
 	}
@@ -3084,7 +3118,7 @@ func (cf DirectusRoles) DeepCopy() IDirectusObject {
 	new_obj.EnforceTfa = cf.EnforceTfa
 	new_obj.Icon = cf.Icon
 	new_obj.Id = cf.Id
-	new_obj.IpAccess = cf.IpAccess
+	new_obj.IpAccess = cf.IpAccess.DeepCopy()
 	new_obj.Name = cf.Name
 	if cf.Users != nil {
 		new_obj.Users = make([]DirectusUsers, len(cf.Users))
@@ -3128,7 +3162,7 @@ func (cf DirectusRoles) Diff(old IDirectusObject) map[string]interface{} {
 		diff["id"] = cf.Id
 	}
 
-	if cf.IpAccess != old.(*DirectusRoles).IpAccess {
+	if !cf.IpAccess.Equal(old.(*DirectusRoles).IpAccess) {
 		diff["ip_access"] = cf.IpAccess
 	}
 
@@ -3178,86 +3212,86 @@ func (cf DirectusRoles) CollectionName() string {
 
 type DirectusSettings struct {
 	IDirectusObject
-	AuthLoginAttempts     *int             `json:"auth_login_attempts"`
-	AuthPasswordPolicy    *string          `json:"auth_password_policy"`
-	Basemaps              any              `json:"basemaps"`
-	BrandingDivider       any              `json:"branding_divider"`
-	CustomAspectRatios    any              `json:"custom_aspect_ratios"`
-	CustomCss             *string          `json:"custom_css"`
-	DefaultAppearance     string           `json:"default_appearance"`
-	DefaultLanguage       string           `json:"default_language"`
-	DefaultThemeDark      *string          `json:"default_theme_dark"`
-	DefaultThemeLight     *string          `json:"default_theme_light"`
-	FilesDivider          any              `json:"files_divider"`
-	Id                    int              `json:"id"`
-	ImageEditor           any              `json:"image_editor"`
-	MapDivider            any              `json:"map_divider"`
-	MapboxKey             *string          `json:"mapbox_key"`
-	ModuleBar             any              `json:"module_bar"`
-	ModulesDivider        any              `json:"modules_divider"`
-	ProjectColor          string           `json:"project_color"`
-	ProjectDescriptor     *string          `json:"project_descriptor"`
-	ProjectLogo           *DirectusFiles   `json:"project_logo"`
-	ProjectName           string           `json:"project_name"`
-	ProjectUrl            *string          `json:"project_url"`
-	PublicBackground      *DirectusFiles   `json:"public_background"`
-	PublicFavicon         *DirectusFiles   `json:"public_favicon"`
-	PublicForeground      *DirectusFiles   `json:"public_foreground"`
-	PublicNote            *string          `json:"public_note"`
-	ReportBugUrl          *string          `json:"report_bug_url"`
-	ReportErrorUrl        *string          `json:"report_error_url"`
-	ReportFeatureUrl      *string          `json:"report_feature_url"`
-	ReportingDivider      any              `json:"reporting_divider"`
-	SecurityDivider       any              `json:"security_divider"`
-	StorageAssetPresets   any              `json:"storage_asset_presets"`
-	StorageAssetTransform *string          `json:"storage_asset_transform"`
-	StorageDefaultFolder  *DirectusFolders `json:"storage_default_folder"`
-	ThemeDarkOverrides    any              `json:"theme_dark_overrides"`
-	ThemeLightOverrides   any              `json:"theme_light_overrides"`
-	ThemingDivider        any              `json:"theming_divider"`
-	ThemingGroup          any              `json:"theming_group"`
+	AuthLoginAttempts     *int                   `json:"auth_login_attempts"`
+	AuthPasswordPolicy    *string                `json:"auth_password_policy"`
+	Basemaps              JSON[[]map[string]any] `json:"basemaps"`
+	BrandingDivider       any                    `json:"branding_divider"`
+	CustomAspectRatios    JSON[[]map[string]any] `json:"custom_aspect_ratios"`
+	CustomCss             *string                `json:"custom_css"`
+	DefaultAppearance     string                 `json:"default_appearance"`
+	DefaultLanguage       string                 `json:"default_language"`
+	DefaultThemeDark      *string                `json:"default_theme_dark"`
+	DefaultThemeLight     *string                `json:"default_theme_light"`
+	FilesDivider          any                    `json:"files_divider"`
+	Id                    int                    `json:"id"`
+	ImageEditor           any                    `json:"image_editor"`
+	MapDivider            any                    `json:"map_divider"`
+	MapboxKey             *string                `json:"mapbox_key"`
+	ModuleBar             JSON[[]map[string]any] `json:"module_bar"`
+	ModulesDivider        any                    `json:"modules_divider"`
+	ProjectColor          string                 `json:"project_color"`
+	ProjectDescriptor     *string                `json:"project_descriptor"`
+	ProjectLogo           *DirectusFiles         `json:"project_logo"`
+	ProjectName           string                 `json:"project_name"`
+	ProjectUrl            *string                `json:"project_url"`
+	PublicBackground      *DirectusFiles         `json:"public_background"`
+	PublicFavicon         *DirectusFiles         `json:"public_favicon"`
+	PublicForeground      *DirectusFiles         `json:"public_foreground"`
+	PublicNote            *string                `json:"public_note"`
+	ReportBugUrl          *string                `json:"report_bug_url"`
+	ReportErrorUrl        *string                `json:"report_error_url"`
+	ReportFeatureUrl      *string                `json:"report_feature_url"`
+	ReportingDivider      any                    `json:"reporting_divider"`
+	SecurityDivider       any                    `json:"security_divider"`
+	StorageAssetPresets   JSON[[]map[string]any] `json:"storage_asset_presets"`
+	StorageAssetTransform *string                `json:"storage_asset_transform"`
+	StorageDefaultFolder  *DirectusFolders       `json:"storage_default_folder"`
+	ThemeDarkOverrides    JSON[map[string]any]   `json:"theme_dark_overrides"`
+	ThemeLightOverrides   JSON[map[string]any]   `json:"theme_light_overrides"`
+	ThemingDivider        any                    `json:"theming_divider"`
+	ThemingGroup          any                    `json:"theming_group"`
 }
 
 func (cf *DirectusSettings) UnmarshalJSON(data []byte) error {
 	type directussettings_internal struct {
-		AuthLoginAttempts     *int             `json:"auth_login_attempts"`
-		AuthPasswordPolicy    *string          `json:"auth_password_policy"`
-		Basemaps              any              `json:"basemaps"`
-		BrandingDivider       any              `json:"branding_divider"`
-		CustomAspectRatios    any              `json:"custom_aspect_ratios"`
-		CustomCss             *string          `json:"custom_css"`
-		DefaultAppearance     string           `json:"default_appearance"`
-		DefaultLanguage       string           `json:"default_language"`
-		DefaultThemeDark      *string          `json:"default_theme_dark"`
-		DefaultThemeLight     *string          `json:"default_theme_light"`
-		FilesDivider          any              `json:"files_divider"`
-		Id                    int              `json:"id"`
-		ImageEditor           any              `json:"image_editor"`
-		MapDivider            any              `json:"map_divider"`
-		MapboxKey             *string          `json:"mapbox_key"`
-		ModuleBar             any              `json:"module_bar"`
-		ModulesDivider        any              `json:"modules_divider"`
-		ProjectColor          string           `json:"project_color"`
-		ProjectDescriptor     *string          `json:"project_descriptor"`
-		ProjectLogo           *DirectusFiles   `json:"project_logo"`
-		ProjectName           string           `json:"project_name"`
-		ProjectUrl            *string          `json:"project_url"`
-		PublicBackground      *DirectusFiles   `json:"public_background"`
-		PublicFavicon         *DirectusFiles   `json:"public_favicon"`
-		PublicForeground      *DirectusFiles   `json:"public_foreground"`
-		PublicNote            *string          `json:"public_note"`
-		ReportBugUrl          *string          `json:"report_bug_url"`
-		ReportErrorUrl        *string          `json:"report_error_url"`
-		ReportFeatureUrl      *string          `json:"report_feature_url"`
-		ReportingDivider      any              `json:"reporting_divider"`
-		SecurityDivider       any              `json:"security_divider"`
-		StorageAssetPresets   any              `json:"storage_asset_presets"`
-		StorageAssetTransform *string          `json:"storage_asset_transform"`
-		StorageDefaultFolder  *DirectusFolders `json:"storage_default_folder"`
-		ThemeDarkOverrides    any              `json:"theme_dark_overrides"`
-		ThemeLightOverrides   any              `json:"theme_light_overrides"`
-		ThemingDivider        any              `json:"theming_divider"`
-		ThemingGroup          any              `json:"theming_group"`
+		AuthLoginAttempts     *int                   `json:"auth_login_attempts"`
+		AuthPasswordPolicy    *string                `json:"auth_password_policy"`
+		Basemaps              JSON[[]map[string]any] `json:"basemaps"`
+		BrandingDivider       any                    `json:"branding_divider"`
+		CustomAspectRatios    JSON[[]map[string]any] `json:"custom_aspect_ratios"`
+		CustomCss             *string                `json:"custom_css"`
+		DefaultAppearance     string                 `json:"default_appearance"`
+		DefaultLanguage       string                 `json:"default_language"`
+		DefaultThemeDark      *string                `json:"default_theme_dark"`
+		DefaultThemeLight     *string                `json:"default_theme_light"`
+		FilesDivider          any                    `json:"files_divider"`
+		Id                    int                    `json:"id"`
+		ImageEditor           any                    `json:"image_editor"`
+		MapDivider            any                    `json:"map_divider"`
+		MapboxKey             *string                `json:"mapbox_key"`
+		ModuleBar             JSON[[]map[string]any] `json:"module_bar"`
+		ModulesDivider        any                    `json:"modules_divider"`
+		ProjectColor          string                 `json:"project_color"`
+		ProjectDescriptor     *string                `json:"project_descriptor"`
+		ProjectLogo           *DirectusFiles         `json:"project_logo"`
+		ProjectName           string                 `json:"project_name"`
+		ProjectUrl            *string                `json:"project_url"`
+		PublicBackground      *DirectusFiles         `json:"public_background"`
+		PublicFavicon         *DirectusFiles         `json:"public_favicon"`
+		PublicForeground      *DirectusFiles         `json:"public_foreground"`
+		PublicNote            *string                `json:"public_note"`
+		ReportBugUrl          *string                `json:"report_bug_url"`
+		ReportErrorUrl        *string                `json:"report_error_url"`
+		ReportFeatureUrl      *string                `json:"report_feature_url"`
+		ReportingDivider      any                    `json:"reporting_divider"`
+		SecurityDivider       any                    `json:"security_divider"`
+		StorageAssetPresets   JSON[[]map[string]any] `json:"storage_asset_presets"`
+		StorageAssetTransform *string                `json:"storage_asset_transform"`
+		StorageDefaultFolder  *DirectusFolders       `json:"storage_default_folder"`
+		ThemeDarkOverrides    JSON[map[string]any]   `json:"theme_dark_overrides"`
+		ThemeLightOverrides   JSON[map[string]any]   `json:"theme_light_overrides"`
+		ThemingDivider        any                    `json:"theming_divider"`
+		ThemingGroup          any                    `json:"theming_group"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -3323,9 +3357,9 @@ func (cf DirectusSettings) DeepCopy() IDirectusObject {
 		new_obj.AuthPasswordPolicy = &temp
 		*new_obj.AuthPasswordPolicy = *cf.AuthPasswordPolicy
 	}
-	new_obj.Basemaps = cf.Basemaps
+	new_obj.Basemaps = cf.Basemaps.DeepCopy()
 	new_obj.BrandingDivider = cf.BrandingDivider
-	new_obj.CustomAspectRatios = cf.CustomAspectRatios
+	new_obj.CustomAspectRatios = cf.CustomAspectRatios.DeepCopy()
 	if cf.CustomCss != nil {
 		temp := ""
 		new_obj.CustomCss = &temp
@@ -3352,7 +3386,7 @@ func (cf DirectusSettings) DeepCopy() IDirectusObject {
 		new_obj.MapboxKey = &temp
 		*new_obj.MapboxKey = *cf.MapboxKey
 	}
-	new_obj.ModuleBar = cf.ModuleBar
+	new_obj.ModuleBar = cf.ModuleBar.DeepCopy()
 	new_obj.ModulesDivider = cf.ModulesDivider
 	new_obj.ProjectColor = cf.ProjectColor
 	if cf.ProjectDescriptor != nil {
@@ -3400,7 +3434,7 @@ func (cf DirectusSettings) DeepCopy() IDirectusObject {
 	}
 	new_obj.ReportingDivider = cf.ReportingDivider
 	new_obj.SecurityDivider = cf.SecurityDivider
-	new_obj.StorageAssetPresets = cf.StorageAssetPresets
+	new_obj.StorageAssetPresets = cf.StorageAssetPresets.DeepCopy()
 	if cf.StorageAssetTransform != nil {
 		temp := ""
 		new_obj.StorageAssetTransform = &temp
@@ -3409,8 +3443,8 @@ func (cf DirectusSettings) DeepCopy() IDirectusObject {
 	if cf.StorageDefaultFolder != nil {
 		new_obj.StorageDefaultFolder = (*cf.StorageDefaultFolder).DeepCopy().(*DirectusFolders)
 	}
-	new_obj.ThemeDarkOverrides = cf.ThemeDarkOverrides
-	new_obj.ThemeLightOverrides = cf.ThemeLightOverrides
+	new_obj.ThemeDarkOverrides = cf.ThemeDarkOverrides.DeepCopy()
+	new_obj.ThemeLightOverrides = cf.ThemeLightOverrides.DeepCopy()
 	new_obj.ThemingDivider = cf.ThemingDivider
 	new_obj.ThemingGroup = cf.ThemingGroup
 	return new_obj
@@ -3445,7 +3479,7 @@ func (cf DirectusSettings) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.Basemaps != old.(*DirectusSettings).Basemaps {
+	if !cf.Basemaps.Equal(old.(*DirectusSettings).Basemaps) {
 		diff["basemaps"] = cf.Basemaps
 	}
 
@@ -3453,7 +3487,7 @@ func (cf DirectusSettings) Diff(old IDirectusObject) map[string]interface{} {
 		diff["branding_divider"] = cf.BrandingDivider
 	}
 
-	if cf.CustomAspectRatios != old.(*DirectusSettings).CustomAspectRatios {
+	if !cf.CustomAspectRatios.Equal(old.(*DirectusSettings).CustomAspectRatios) {
 		diff["custom_aspect_ratios"] = cf.CustomAspectRatios
 	}
 	if cf.CustomCss == nil {
@@ -3533,7 +3567,7 @@ func (cf DirectusSettings) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.ModuleBar != old.(*DirectusSettings).ModuleBar {
+	if !cf.ModuleBar.Equal(old.(*DirectusSettings).ModuleBar) {
 		diff["module_bar"] = cf.ModuleBar
 	}
 
@@ -3636,7 +3670,7 @@ func (cf DirectusSettings) Diff(old IDirectusObject) map[string]interface{} {
 		diff["security_divider"] = cf.SecurityDivider
 	}
 
-	if cf.StorageAssetPresets != old.(*DirectusSettings).StorageAssetPresets {
+	if !cf.StorageAssetPresets.Equal(old.(*DirectusSettings).StorageAssetPresets) {
 		diff["storage_asset_presets"] = cf.StorageAssetPresets
 	}
 	if cf.StorageAssetTransform == nil {
@@ -3653,11 +3687,11 @@ func (cf DirectusSettings) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.ThemeDarkOverrides != old.(*DirectusSettings).ThemeDarkOverrides {
+	if !cf.ThemeDarkOverrides.Equal(old.(*DirectusSettings).ThemeDarkOverrides) {
 		diff["theme_dark_overrides"] = cf.ThemeDarkOverrides
 	}
 
-	if cf.ThemeLightOverrides != old.(*DirectusSettings).ThemeLightOverrides {
+	if !cf.ThemeLightOverrides.Equal(old.(*DirectusSettings).ThemeLightOverrides) {
 		diff["theme_light_overrides"] = cf.ThemeLightOverrides
 	}
 
@@ -4094,72 +4128,91 @@ func (cf DirectusTranslations) CollectionName() string {
 	return "directus_translations"
 }
 
+type DirectusUsersStatus string
+
+const (
+	DirectusUsersStatusDraft      = DirectusUsersStatus("draft")
+	DirectusUsersStatusInvited    = DirectusUsersStatus("invited")
+	DirectusUsersStatusUnverified = DirectusUsersStatus("unverified")
+	DirectusUsersStatusActive     = DirectusUsersStatus("active")
+	DirectusUsersStatusSuspended  = DirectusUsersStatus("suspended")
+	DirectusUsersStatusArchived   = DirectusUsersStatus("archived")
+)
+
+func (e DirectusUsersStatus) IsValid() bool {
+	switch e {
+	case DirectusUsersStatusDraft, DirectusUsersStatusInvited, DirectusUsersStatusUnverified, DirectusUsersStatusActive, DirectusUsersStatusSuspended, DirectusUsersStatusArchived:
+		return true
+	}
+	return false
+}
+
 type DirectusUsers struct {
 	IDirectusObject
-	AdminDivider        any            `json:"admin_divider"`
-	Appearance          *string        `json:"appearance"`
-	AuthData            any            `json:"auth_data"`
-	Avatar              *DirectusFiles `json:"avatar"`
-	Description         *string        `json:"description"`
-	Email               *string        `json:"email"`
-	EmailNotifications  *bool          `json:"email_notifications"`
-	ExternalIdentifier  *string        `json:"external_identifier"`
-	FirstName           *string        `json:"first_name"`
-	Id                  uuid.UUID      `json:"id"`
-	Language            *string        `json:"language"`
-	LastAccess          *time.Time     `json:"last_access"`
-	LastName            *string        `json:"last_name"`
-	LastPage            *string        `json:"last_page"`
-	Location            *string        `json:"location"`
-	Password            *string        `json:"password"`
-	PreferencesDivider  any            `json:"preferences_divider"`
-	Provider            string         `json:"provider"`
-	Role                *DirectusRoles `json:"role"`
-	Status              string         `json:"status"`
-	Tags                any            `json:"tags"`
-	TelegramChatId      *string        `json:"telegram_chat_id"`
-	TfaSecret           *string        `json:"tfa_secret"`
-	ThemeDark           *string        `json:"theme_dark"`
-	ThemeDarkOverrides  any            `json:"theme_dark_overrides"`
-	ThemeLight          *string        `json:"theme_light"`
-	ThemeLightOverrides any            `json:"theme_light_overrides"`
-	ThemingDivider      any            `json:"theming_divider"`
-	Title               *string        `json:"title"`
-	Token               *string        `json:"token"`
+	AdminDivider        any                  `json:"admin_divider"`
+	Appearance          *string              `json:"appearance"`
+	AuthData            JSON[any]            `json:"auth_data"`
+	Avatar              *DirectusFiles       `json:"avatar"`
+	Description         *string              `json:"description"`
+	Email               *string              `json:"email"`
+	EmailNotifications  *bool                `json:"email_notifications"`
+	ExternalIdentifier  *string              `json:"external_identifier"`
+	FirstName           *string              `json:"first_name"`
+	Id                  uuid.UUID            `json:"id"`
+	Language            *string              `json:"language"`
+	LastAccess          *time.Time           `json:"last_access"`
+	LastName            *string              `json:"last_name"`
+	LastPage            *string              `json:"last_page"`
+	Location            *string              `json:"location"`
+	Password            *string              `json:"password"`
+	PreferencesDivider  any                  `json:"preferences_divider"`
+	Provider            string               `json:"provider"`
+	Role                *DirectusRoles       `json:"role"`
+	Status              DirectusUsersStatus  `json:"status"`
+	Tags                JSON[[]string]       `json:"tags"`
+	TelegramChatId      *string              `json:"telegram_chat_id"`
+	TfaSecret           *string              `json:"tfa_secret"`
+	ThemeDark           *string              `json:"theme_dark"`
+	ThemeDarkOverrides  JSON[map[string]any] `json:"theme_dark_overrides"`
+	ThemeLight          *string              `json:"theme_light"`
+	ThemeLightOverrides JSON[map[string]any] `json:"theme_light_overrides"`
+	ThemingDivider      any                  `json:"theming_divider"`
+	Title               *string              `json:"title"`
+	Token               *string              `json:"token"`
 }
 
 func (cf *DirectusUsers) UnmarshalJSON(data []byte) error {
 	type directususers_internal struct {
-		AdminDivider        any            `json:"admin_divider"`
-		Appearance          *string        `json:"appearance"`
-		AuthData            any            `json:"auth_data"`
-		Avatar              *DirectusFiles `json:"avatar"`
-		Description         *string        `json:"description"`
-		Email               *string        `json:"email"`
-		EmailNotifications  *bool          `json:"email_notifications"`
-		ExternalIdentifier  *string        `json:"external_identifier"`
-		FirstName           *string        `json:"first_name"`
-		Id                  uuid.UUID      `json:"id"`
-		Language            *string        `json:"language"`
-		LastAccess          *time.Time     `json:"last_access"`
-		LastName            *string        `json:"last_name"`
-		LastPage            *string        `json:"last_page"`
-		Location            *string        `json:"location"`
-		Password            *string        `json:"password"`
-		PreferencesDivider  any            `json:"preferences_divider"`
-		Provider            string         `json:"provider"`
-		Role                *DirectusRoles `json:"role"`
-		Status              string         `json:"status"`
-		Tags                any            `json:"tags"`
-		TelegramChatId      *string        `json:"telegram_chat_id"`
-		TfaSecret           *string        `json:"tfa_secret"`
-		ThemeDark           *string        `json:"theme_dark"`
-		ThemeDarkOverrides  any            `json:"theme_dark_overrides"`
-		ThemeLight          *string        `json:"theme_light"`
-		ThemeLightOverrides any            `json:"theme_light_overrides"`
-		ThemingDivider      any            `json:"theming_divider"`
-		Title               *string        `json:"title"`
-		Token               *string        `json:"token"`
+		AdminDivider        any                  `json:"admin_divider"`
+		Appearance          *string              `json:"appearance"`
+		AuthData            JSON[any]            `json:"auth_data"`
+		Avatar              *DirectusFiles       `json:"avatar"`
+		Description         *string              `json:"description"`
+		Email               *string              `json:"email"`
+		EmailNotifications  *bool                `json:"email_notifications"`
+		ExternalIdentifier  *string              `json:"external_identifier"`
+		FirstName           *string              `json:"first_name"`
+		Id                  uuid.UUID            `json:"id"`
+		Language            *string              `json:"language"`
+		LastAccess          *time.Time           `json:"last_access"`
+		LastName            *string              `json:"last_name"`
+		LastPage            *string              `json:"last_page"`
+		Location            *string              `json:"location"`
+		Password            *string              `json:"password"`
+		PreferencesDivider  any                  `json:"preferences_divider"`
+		Provider            string               `json:"provider"`
+		Role                *DirectusRoles       `json:"role"`
+		Status              DirectusUsersStatus  `json:"status"`
+		Tags                JSON[[]string]       `json:"tags"`
+		TelegramChatId      *string              `json:"telegram_chat_id"`
+		TfaSecret           *string              `json:"tfa_secret"`
+		ThemeDark           *string              `json:"theme_dark"`
+		ThemeDarkOverrides  JSON[map[string]any] `json:"theme_dark_overrides"`
+		ThemeLight          *string              `json:"theme_light"`
+		ThemeLightOverrides JSON[map[string]any] `json:"theme_light_overrides"`
+		ThemingDivider      any                  `json:"theming_divider"`
+		Title               *string              `json:"title"`
+		Token               *string              `json:"token"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -4213,7 +4266,7 @@ func (cf DirectusUsers) DeepCopy() IDirectusObject {
 		new_obj.Appearance = &temp
 		*new_obj.Appearance = *cf.Appearance
 	}
-	new_obj.AuthData = cf.AuthData
+	new_obj.AuthData = cf.AuthData.DeepCopy()
 	if cf.Avatar != nil {
 		new_obj.Avatar = (*cf.Avatar).DeepCopy().(*DirectusFiles)
 	}
@@ -4279,7 +4332,7 @@ func (cf DirectusUsers) DeepCopy() IDirectusObject {
 		new_obj.Role = (*cf.Role).DeepCopy().(*DirectusRoles)
 	}
 	new_obj.Status = cf.Status
-	new_obj.Tags = cf.Tags
+	new_obj.Tags = cf.Tags.DeepCopy()
 	if cf.TelegramChatId != nil {
 		temp := ""
 		new_obj.TelegramChatId = &temp
@@ -4295,13 +4348,13 @@ func (cf DirectusUsers) DeepCopy() IDirectusObject {
 		new_obj.ThemeDark = &temp
 		*new_obj.ThemeDark = *cf.ThemeDark
 	}
-	new_obj.ThemeDarkOverrides = cf.ThemeDarkOverrides
+	new_obj.ThemeDarkOverrides = cf.ThemeDarkOverrides.DeepCopy()
 	if cf.ThemeLight != nil {
 		temp := ""
 		new_obj.ThemeLight = &temp
 		*new_obj.ThemeLight = *cf.ThemeLight
 	}
-	new_obj.ThemeLightOverrides = cf.ThemeLightOverrides
+	new_obj.ThemeLightOverrides = cf.ThemeLightOverrides.DeepCopy()
 	new_obj.ThemingDivider = cf.ThemingDivider
 	if cf.Title != nil {
 		temp := ""
@@ -4335,7 +4388,7 @@ func (cf DirectusUsers) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.AuthData != old.(*DirectusUsers).AuthData {
+	if !cf.AuthData.Equal(old.(*DirectusUsers).AuthData) {
 		diff["auth_data"] = cf.AuthData
 	}
 
@@ -4499,7 +4552,7 @@ func (cf DirectusUsers) Diff(old IDirectusObject) map[string]interface{} {
 		diff["status"] = cf.Status
 	}
 
-	if cf.Tags != old.(*DirectusUsers).Tags {
+	if !cf.Tags.Equal(old.(*DirectusUsers).Tags) {
 		diff["tags"] = cf.Tags
 	}
 	if cf.TelegramChatId == nil {
@@ -4542,7 +4595,7 @@ func (cf DirectusUsers) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.ThemeDarkOverrides != old.(*DirectusUsers).ThemeDarkOverrides {
+	if !cf.ThemeDarkOverrides.Equal(old.(*DirectusUsers).ThemeDarkOverrides) {
 		diff["theme_dark_overrides"] = cf.ThemeDarkOverrides
 	}
 	if cf.ThemeLight == nil {
@@ -4559,7 +4612,7 @@ func (cf DirectusUsers) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.ThemeLightOverrides != old.(*DirectusUsers).ThemeLightOverrides {
+	if !cf.ThemeLightOverrides.Equal(old.(*DirectusUsers).ThemeLightOverrides) {
 		diff["theme_light_overrides"] = cf.ThemeLightOverrides
 	}
 
@@ -4849,36 +4902,51 @@ func (cf DirectusVersions) CollectionName() string {
 	return "directus_versions"
 }
 
+type DirectusWebhooksStatus string
+
+const (
+	DirectusWebhooksStatusActive   = DirectusWebhooksStatus("active")
+	DirectusWebhooksStatusInactive = DirectusWebhooksStatus("inactive")
+)
+
+func (e DirectusWebhooksStatus) IsValid() bool {
+	switch e {
+	case DirectusWebhooksStatusActive, DirectusWebhooksStatusInactive:
+		return true
+	}
+	return false
+}
+
 type DirectusWebhooks struct {
 	IDirectusObject
-	Actions                    any        `json:"actions"`
-	Collections                any        `json:"collections"`
-	Data                       bool       `json:"data"`
-	Headers                    any        `json:"headers"`
-	Id                         int        `json:"id"`
-	Method                     string     `json:"method"`
-	MigratedFlow               *uuid.UUID `json:"migrated_flow"`
-	Name                       string     `json:"name"`
-	Status                     string     `json:"status"`
-	TriggersDivider            any        `json:"triggers_divider"`
-	Url                        string     `json:"url"`
-	WasActiveBeforeDeprecation bool       `json:"was_active_before_deprecation"`
+	Actions                    JSON[[]string]         `json:"actions"`
+	Collections                JSON[[]string]         `json:"collections"`
+	Data                       bool                   `json:"data"`
+	Headers                    JSON[[]map[string]any] `json:"headers"`
+	Id                         int                    `json:"id"`
+	Method                     string                 `json:"method"`
+	MigratedFlow               *uuid.UUID             `json:"migrated_flow"`
+	Name                       string                 `json:"name"`
+	Status                     DirectusWebhooksStatus `json:"status"`
+	TriggersDivider            any                    `json:"triggers_divider"`
+	Url                        string                 `json:"url"`
+	WasActiveBeforeDeprecation bool                   `json:"was_active_before_deprecation"`
 }
 
 func (cf *DirectusWebhooks) UnmarshalJSON(data []byte) error {
 	type directuswebhooks_internal struct {
-		Actions                    any        `json:"actions"`
-		Collections                any        `json:"collections"`
-		Data                       bool       `json:"data"`
-		Headers                    any        `json:"headers"`
-		Id                         int        `json:"id"`
-		Method                     string     `json:"method"`
-		MigratedFlow               *uuid.UUID `json:"migrated_flow"`
-		Name                       string     `json:"name"`
-		Status                     string     `json:"status"`
-		TriggersDivider            any        `json:"triggers_divider"`
-		Url                        string     `json:"url"`
-		WasActiveBeforeDeprecation bool       `json:"was_active_before_deprecation"`
+		Actions                    JSON[[]string]         `json:"actions"`
+		Collections                JSON[[]string]         `json:"collections"`
+		Data                       bool                   `json:"data"`
+		Headers                    JSON[[]map[string]any] `json:"headers"`
+		Id                         int                    `json:"id"`
+		Method                     string                 `json:"method"`
+		MigratedFlow               *uuid.UUID             `json:"migrated_flow"`
+		Name                       string                 `json:"name"`
+		Status                     DirectusWebhooksStatus `json:"status"`
+		TriggersDivider            any                    `json:"triggers_divider"`
+		Url                        string                 `json:"url"`
+		WasActiveBeforeDeprecation bool                   `json:"was_active_before_deprecation"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -4908,10 +4976,10 @@ func (cf *DirectusWebhooks) UnmarshalJSON(data []byte) error {
 }
 func (cf DirectusWebhooks) DeepCopy() IDirectusObject {
 	new_obj := &DirectusWebhooks{}
-	new_obj.Actions = cf.Actions
-	new_obj.Collections = cf.Collections
+	new_obj.Actions = cf.Actions.DeepCopy()
+	new_obj.Collections = cf.Collections.DeepCopy()
 	new_obj.Data = cf.Data
-	new_obj.Headers = cf.Headers
+	new_obj.Headers = cf.Headers.DeepCopy()
 	new_obj.Id = cf.Id
 	new_obj.Method = cf.Method
 	if cf.MigratedFlow != nil {
@@ -4929,11 +4997,11 @@ func (cf DirectusWebhooks) DeepCopy() IDirectusObject {
 func (cf DirectusWebhooks) Diff(old IDirectusObject) map[string]interface{} {
 	diff := make(map[string]interface{})
 
-	if cf.Actions != old.(*DirectusWebhooks).Actions {
+	if !cf.Actions.Equal(old.(*DirectusWebhooks).Actions) {
 		diff["actions"] = cf.Actions
 	}
 
-	if cf.Collections != old.(*DirectusWebhooks).Collections {
+	if !cf.Collections.Equal(old.(*DirectusWebhooks).Collections) {
 		diff["collections"] = cf.Collections
 	}
 
@@ -4941,7 +5009,7 @@ func (cf DirectusWebhooks) Diff(old IDirectusObject) map[string]interface{} {
 		diff["data"] = cf.Data
 	}
 
-	if cf.Headers != old.(*DirectusWebhooks).Headers {
+	if !cf.Headers.Equal(old.(*DirectusWebhooks).Headers) {
 		diff["headers"] = cf.Headers
 	}
 
@@ -5112,7 +5180,7 @@ type Product struct {
 	Id          uuid.UUID `json:"id"`
 	Location    *Location `json:"location"`
 	Name        string    `json:"name"`
-	Price       float32   `json:"price"`
+	Price       Decimal   `json:"price"`
 }
 
 func (cf *Product) UnmarshalJSON(data []byte) error {
@@ -5122,7 +5190,7 @@ func (cf *Product) UnmarshalJSON(data []byte) error {
 		Id          uuid.UUID `json:"id"`
 		Location    *Location `json:"location"`
 		Name        string    `json:"name"`
-		Price       float32   `json:"price"`
+		Price       Decimal   `json:"price"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -5189,7 +5257,7 @@ func (cf Product) Diff(old IDirectusObject) map[string]interface{} {
 		diff["name"] = cf.Name
 	}
 
-	if cf.Price != old.(*Product).Price {
+	if !cf.Price.Equal(old.(*Product).Price) {
 		diff["price"] = cf.Price
 	}
 
@@ -5235,7 +5303,7 @@ type Promocode struct {
 	Code        string         `json:"code"`
 	DateCreated *time.Time     `json:"date_created"`
 	DateUpdated *time.Time     `json:"date_updated"`
-	Discount    float32        `json:"discount"`
+	Discount    Decimal        `json:"discount"`
 	Id          uuid.UUID      `json:"id"`
 	UserCreated *DirectusUsers `json:"user_created"`
 	UserUpdated *DirectusUsers `json:"user_updated"`
@@ -5246,7 +5314,7 @@ func (cf *Promocode) UnmarshalJSON(data []byte) error {
 		Code        string         `json:"code"`
 		DateCreated *time.Time     `json:"date_created"`
 		DateUpdated *time.Time     `json:"date_updated"`
-		Discount    float32        `json:"discount"`
+		Discount    Decimal        `json:"discount"`
 		Id          uuid.UUID      `json:"id"`
 		UserCreated *DirectusUsers `json:"user_created"`
 		UserUpdated *DirectusUsers `json:"user_updated"`
@@ -5328,7 +5396,7 @@ func (cf Promocode) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.Discount != old.(*Promocode).Discount {
+	if !cf.Discount.Equal(old.(*Promocode).Discount) {
 		diff["discount"] = cf.Discount
 	}
 
@@ -5488,18 +5556,35 @@ func (cf ProxyServer) CollectionName() string {
 	return "proxy_server"
 }
 
+type SlotStatus string
+
+// Choices of status field created by directus for new collections
+const (
+	SlotStatusPublished = SlotStatus("published")
+	SlotStatusDraft     = SlotStatus("draft")
+	SlotStatusArchived  = SlotStatus("archived")
+)
+
+func (e SlotStatus) IsValid() bool {
+	switch e {
+	case SlotStatusPublished, SlotStatusDraft, SlotStatusArchived:
+		return true
+	}
+	return false
+}
+
 type Slot struct {
 	IDirectusObject
 	Annotation     *string        `json:"annotation"`
 	ConnectionPort int            `json:"connection_port"`
 	DateCreated    *time.Time     `json:"date_created"`
 	DateUpdated    *time.Time     `json:"date_updated"`
-	ExpiresAt      time.Time      `json:"expires_at"`
+	ExpiresAt      LocalDateTime  `json:"expires_at"`
 	Id             uuid.UUID      `json:"id"`
 	PasswordBase64 string         `json:"password_base64"`
 	Product        *Product       `json:"product"`
 	Server         *ProxyServer   `json:"server"`
-	Status         *string        `json:"status"`
+	Status         *SlotStatus    `json:"status"`
 	Transaction    *Transaction   `json:"transaction"`
 	UsedPromocode  *Promocode     `json:"used_promocode"`
 	User           *DirectusUsers `json:"user"`
@@ -5513,12 +5598,12 @@ func (cf *Slot) UnmarshalJSON(data []byte) error {
 		ConnectionPort int            `json:"connection_port"`
 		DateCreated    *time.Time     `json:"date_created"`
 		DateUpdated    *time.Time     `json:"date_updated"`
-		ExpiresAt      time.Time      `json:"expires_at"`
+		ExpiresAt      LocalDateTime  `json:"expires_at"`
 		Id             uuid.UUID      `json:"id"`
 		PasswordBase64 string         `json:"password_base64"`
 		Product        *Product       `json:"product"`
 		Server         *ProxyServer   `json:"server"`
-		Status         *string        `json:"status"`
+		Status         *SlotStatus    `json:"status"`
 		Transaction    *Transaction   `json:"transaction"`
 		UsedPromocode  *Promocode     `json:"used_promocode"`
 		User           *DirectusUsers `json:"user"`
@@ -5582,7 +5667,7 @@ func (cf Slot) DeepCopy() IDirectusObject {
 		new_obj.Server = (*cf.Server).DeepCopy().(*ProxyServer)
 	}
 	if cf.Status != nil {
-		temp := ""
+		temp := SlotStatus("")
 		new_obj.Status = &temp
 		*new_obj.Status = *cf.Status
 	}
@@ -5650,7 +5735,7 @@ func (cf Slot) Diff(old IDirectusObject) map[string]interface{} {
 		}
 	}
 
-	if cf.ExpiresAt != old.(*Slot).ExpiresAt {
+	if !cf.ExpiresAt.Equal(old.(*Slot).ExpiresAt) {
 		diff["expires_at"] = cf.ExpiresAt
 	}
 
@@ -5742,22 +5827,22 @@ func (cf Slot) CollectionName() string {
 
 type Transaction struct {
 	IDirectusObject
-	DateCreated *time.Time     `json:"date_created"`
-	DateUpdated *time.Time     `json:"date_updated"`
-	Id          uuid.UUID      `json:"id"`
-	Metadata    any            `json:"metadata"`
-	UserCreated *DirectusUsers `json:"user_created"`
-	UserUpdated *DirectusUsers `json:"user_updated"`
+	DateCreated *time.Time           `json:"date_created"`
+	DateUpdated *time.Time           `json:"date_updated"`
+	Id          uuid.UUID            `json:"id"`
+	Metadata    JSON[map[string]any] `json:"metadata"`
+	UserCreated *DirectusUsers       `json:"user_created"`
+	UserUpdated *DirectusUsers       `json:"user_updated"`
 }
 
 func (cf *Transaction) UnmarshalJSON(data []byte) error {
 	type transaction_internal struct {
-		DateCreated *time.Time     `json:"date_created"`
-		DateUpdated *time.Time     `json:"date_updated"`
-		Id          uuid.UUID      `json:"id"`
-		Metadata    any            `json:"metadata"`
-		UserCreated *DirectusUsers `json:"user_created"`
-		UserUpdated *DirectusUsers `json:"user_updated"`
+		DateCreated *time.Time           `json:"date_created"`
+		DateUpdated *time.Time           `json:"date_updated"`
+		Id          uuid.UUID            `json:"id"`
+		Metadata    JSON[map[string]any] `json:"metadata"`
+		UserCreated *DirectusUsers       `json:"user_created"`
+		UserUpdated *DirectusUsers       `json:"user_updated"`
 	}
 	if data[0] == '"' { //Data is a string
 		return json.Unmarshal(data, &cf.Id)
@@ -5792,7 +5877,7 @@ func (cf Transaction) DeepCopy() IDirectusObject {
 		*new_obj.DateUpdated = *cf.DateUpdated
 	}
 	new_obj.Id = cf.Id
-	new_obj.Metadata = cf.Metadata
+	new_obj.Metadata = cf.Metadata.DeepCopy()
 	if cf.UserCreated != nil {
 		new_obj.UserCreated = (*cf.UserCreated).DeepCopy().(*DirectusUsers)
 	}
@@ -5835,7 +5920,7 @@ func (cf Transaction) Diff(old IDirectusObject) map[string]interface{} {
 		diff["id"] = cf.Id
 	}
 
-	if cf.Metadata != old.(*Transaction).Metadata {
+	if !cf.Metadata.Equal(old.(*Transaction).Metadata) {
 		diff["metadata"] = cf.Metadata
 	}
 
@@ -0,0 +1,408 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+const VALIDATION_ERROR_CODE = "FAILED_VALIDATION"
+
+// Same shape as errors directus returns for failed validation
+type ValidationError struct {
+	Message    string `json:"message"`
+	Extensions struct {
+		Code       string `json:"code"`
+		Collection string `json:"collection"`
+		Field      string `json:"field"`
+		// Failed rule, e.g. required, max_length, regex, in, gte
+		Type    string `json:"type"`
+		Valid   any    `json:"valid,omitempty"`
+		Invalid any    `json:"invalid,omitempty"`
+	} `json:"extensions"`
+}
+
+type ValidationErrors []ValidationError
+
+func (h ValidationErrors) Error() string {
+	messages := make([]string, len(h))
+	for i, e := range h {
+		messages[i] = e.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (h *ValidationErrors) add(collection, field, rule string, valid, invalid any, message string) {
+	e := ValidationError{Message: message}
+	e.Extensions.Code = VALIDATION_ERROR_CODE
+	e.Extensions.Collection = collection
+	e.Extensions.Field = field
+	e.Extensions.Type = rule
+	e.Extensions.Valid = valid
+	e.Extensions.Invalid = invalid
+	*h = append(*h, e)
+}
+
+// Field metadata as returned by /fields/{collection}
+type fieldInfo struct {
+	Field string `json:"field"`
+	Type  string `json:"type"`
+	Meta  *struct {
+		Interface         *string        `json:"interface"`
+		Required          bool           `json:"required"`
+		Options           map[string]any `json:"options"`
+		Validation        map[string]any `json:"validation"`
+		ValidationMessage *string        `json:"validation_message"`
+	} `json:"meta"`
+	Schema *struct {
+		MaxLength    *int `json:"max_length"`
+		DefaultValue any  `json:"default_value"`
+	} `json:"schema"`
+}
+
+type fieldRules struct {
+	field string
+	// Alias fields like o2m relations have no column and are never part of payload
+	alias             bool
+	required          bool
+	hasDefault        bool
+	maxLength         *int
+	min               *float64
+	max               *float64
+	choices           []any
+	validation        map[string]any
+	validationMessage string
+}
+
+func newFieldRules(info fieldInfo) fieldRules {
+	rules := fieldRules{field: info.Field, alias: info.Type == "alias"}
+	if info.Schema != nil {
+		rules.maxLength = info.Schema.MaxLength
+		rules.hasDefault = info.Schema.DefaultValue != nil
+	}
+	if info.Meta == nil {
+		return rules
+	}
+	rules.required = info.Meta.Required
+	rules.validation = info.Meta.Validation
+	if info.Meta.ValidationMessage != nil {
+		rules.validationMessage = *info.Meta.ValidationMessage
+	}
+	if v, ok := toFloat(info.Meta.Options["min"]); ok {
+		rules.min = &v
+	}
+	if v, ok := toFloat(info.Meta.Options["max"]); ok {
+		rules.max = &v
+	}
+	// Dropdowns accept only listed choices unless custom values are allowed
+	isSelect := info.Meta.Interface != nil && strings.HasPrefix(*info.Meta.Interface, "select-")
+	if choices, ok := info.Meta.Options["choices"].([]any); ok && isSelect && info.Meta.Options["allowOther"] != true {
+		for _, c := range choices {
+			if choice, ok := c.(map[string]any); ok {
+				rules.choices = append(rules.choices, choice["value"])
+			}
+		}
+	}
+	return rules
+}
+
+// LoadValidationRules fetches field metadata of collections used by Validate, rules are cached
+func (h *DirectusApi) LoadValidationRules(collection ...string) error {
+	for _, c := range collection {
+		_, err := h.validationRules(context.Background(), c, true)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *DirectusApi) validationRules(ctx context.Context, collection string, reload bool) (_ []fieldRules, err error) {
+	h.validationMutex.Lock()
+	defer h.validationMutex.Unlock()
+	rules, exists := h.validation[collection]
+	if exists && !reload {
+		return rules, nil
+	}
+
+	op := h.startOperation(ctx, collection, "LoadValidationRules")
+	defer func() { op.end(err) }()
+
+	addr := *h.directusUrl
+	addr.Path = path.Join(addr.Path, fmt.Sprintf("/fields/%s", collection))
+	req, err := http.NewRequest("GET", addr.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.token))
+
+	resp, err := op.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	item := DirectusResponse[[]fieldInfo]{}
+	err = json.NewDecoder(resp.Body).Decode(&item)
+	if err != nil {
+		return nil, err
+	}
+	err = item.err()
+	if err != nil {
+		return nil, err
+	}
+	rules = make([]fieldRules, 0, len(item.Data))
+	for _, info := range item.Data {
+		rules = append(rules, newFieldRules(info))
+	}
+	if h.validation == nil {
+		h.validation = map[string][]fieldRules{}
+	}
+	h.validation[collection] = rules
+	return rules, nil
+}
+
+// Validate checks every field of object against directus field metadata, returns ValidationErrors on failure
+func (h *DirectusApi) Validate(obj IDirectusObject) error {
+	return h.validatePayload(context.Background(), obj.CollectionName(), insertPayload(obj), true)
+}
+
+// Validates fields present in payload, required fields are checked for presence only on create,
+// unless directus fills them with column default.
+// Many-to-one relations are part of create payload, so required relations are checked as well
+func (h *DirectusApi) validatePayload(ctx context.Context, collection string, payload map[string]any, create bool) error {
+	rules, err := h.validationRules(ctx, collection, false)
+	if err != nil {
+		return err
+	}
+	errs := ValidationErrors{}
+	for _, r := range rules {
+		value, present := payload[r.field]
+		if !present {
+			if create && r.required && !r.alias && !r.hasDefault {
+				errs.add(collection, r.field, "required", nil, nil, fmt.Sprintf("Value for field \"%s\" is required", r.field))
+			}
+			continue
+		}
+		r.check(collection, deref(value), &errs)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// Rejects enum values outside of their choices, checked for every created and patched object
+func validateEnums(collection string, payload map[string]any) error {
+	errs := ValidationErrors{}
+	for field, value := range payload {
+		if enum, ok := deref(value).(IDirectusEnum); ok && !enum.IsValid() {
+			errs.add(collection, field, "in", nil, value, fmt.Sprintf("Value \"%v\" is not a valid choice for field \"%s\"", enum, field))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func (h fieldRules) check(collection string, value any, errs *ValidationErrors) {
+	if value == nil {
+		if h.required {
+			errs.add(collection, h.field, "required", nil, nil, fmt.Sprintf("Value for field \"%s\" is required", h.field))
+		} else {
+			// Rules like _nnull apply to null as well
+			h.checkValidation(collection, value, errs)
+		}
+		return
+	}
+	// Kind covers named string types like enums
+	if v := reflect.ValueOf(value); v.Kind() == reflect.String && h.maxLength != nil && len([]rune(v.String())) > *h.maxLength {
+		s := v.String()
+		errs.add(collection, h.field, "max_length", *h.maxLength, s, fmt.Sprintf("Value for field \"%s\" has to be at most %d characters long", h.field, *h.maxLength))
+	}
+	if n, ok := toFloat(value); ok {
+		if h.min != nil && n < *h.min {
+			errs.add(collection, h.field, "gte", *h.min, value, fmt.Sprintf("Value for field \"%s\" has to be greater than or equal to %v", h.field, *h.min))
+		}
+		if h.max != nil && n > *h.max {
+			errs.add(collection, h.field, "lte", *h.max, value, fmt.Sprintf("Value for field \"%s\" has to be less than or equal to %v", h.field, *h.max))
+		}
+	}
+	if h.choices != nil {
+		values := asSlice(value)
+		if values == nil {
+			values = []any{value}
+		}
+		for _, v := range values {
+			if !containsValue(h.choices, v) {
+				errs.add(collection, h.field, "in", h.choices, v, fmt.Sprintf("Value for field \"%s\" has to be one of %v", h.field, h.choices))
+			}
+		}
+	}
+	h.checkValidation(collection, value, errs)
+}
+
+func (h fieldRules) checkValidation(collection string, value any, errs *ValidationErrors) {
+	if h.validation == nil {
+		return
+	}
+	rule, ok := matchFilter(h.validation, h.field, value)
+	if !ok {
+		message := h.validationMessage
+		if message == "" {
+			message = fmt.Sprintf("Value for field \"%s\" does not pass validation rule %s", h.field, rule)
+		}
+		errs.add(collection, h.field, strings.TrimPrefix(rule, "_"), nil, value, message)
+	}
+}
+
+// Evaluates directus filter rule against single field value, returns failed operator
+func matchFilter(filter map[string]any, field string, value any) (string, bool) {
+	for key, cond := range filter {
+		switch key {
+		case string(FILTER_AND):
+			for _, sub := range asSlice(cond) {
+				if m, ok := sub.(map[string]any); ok {
+					if rule, ok := matchFilter(m, field, value); !ok {
+						return rule, false
+					}
+				}
+			}
+		case string(FILTER_OR):
+			matched := false
+			rule := string(FILTER_OR)
+			for _, sub := range asSlice(cond) {
+				if m, ok := sub.(map[string]any); ok {
+					if r, ok := matchFilter(m, field, value); ok {
+						matched = true
+					} else {
+						rule = r
+					}
+				}
+			}
+			if !matched {
+				return rule, false
+			}
+		case field:
+			ops, ok := cond.(map[string]any)
+			if !ok {
+				continue
+			}
+			for op, arg := range ops {
+				if !matchOperator(op, arg, value) {
+					return op, false
+				}
+			}
+		}
+	}
+	return "", true
+}
+
+func matchOperator(op string, arg, value any) bool {
+	s, isString := "", reflect.ValueOf(value).Kind() == reflect.String
+	if isString {
+		s = reflect.ValueOf(value).String()
+	}
+	argString, _ := arg.(string)
+	n, isNumber := toFloat(value)
+	argNumber, argIsNumber := toFloat(arg)
+	switch op {
+	case "_eq":
+		return valuesEqual(value, arg) || (isNumber && argIsNumber && n == argNumber)
+	case "_neq":
+		return !(valuesEqual(value, arg) || (isNumber && argIsNumber && n == argNumber))
+	case "_in":
+		return containsValue(asSlice(arg), value)
+	case "_nin":
+		return !containsValue(asSlice(arg), value)
+	case "_lt":
+		return isNumber && argIsNumber && n < argNumber
+	case "_lte":
+		return isNumber && argIsNumber && n <= argNumber
+	case "_gt":
+		return isNumber && argIsNumber && n > argNumber
+	case "_gte":
+		return isNumber && argIsNumber && n >= argNumber
+	case "_contains":
+		return isString && strings.Contains(s, argString)
+	case "_ncontains":
+		return isString && !strings.Contains(s, argString)
+	case "_icontains":
+		return isString && strings.Contains(strings.ToLower(s), strings.ToLower(argString))
+	case "_starts_with":
+		return isString && strings.HasPrefix(s, argString)
+	case "_ends_with":
+		return isString && strings.HasSuffix(s, argString)
+	case "_regex":
+		re, err := regexp.Compile(strings.Trim(argString, "/"))
+		return err == nil && isString && re.MatchString(s)
+	case "_empty":
+		return isString && s == ""
+	case "_nempty":
+		return !isString || s != ""
+	case "_null":
+		return value == nil
+	case "_nnull":
+		return value != nil
+	}
+	// Unsupported operators are left to the server
+	return true
+}
+
+func deref(value any) any {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func toFloat(value any) (float64, bool) {
+	if d, ok := deref(value).(Decimal); ok {
+		return d.Float64(), true
+	}
+	v := reflect.ValueOf(deref(value))
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+func asSlice(value any) []any {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+	result := make([]any, v.Len())
+	for i := range result {
+		result[i] = v.Index(i).Interface()
+	}
+	return result
+}
+
+func containsValue(values []any, value any) bool {
+	for _, v := range values {
+		if valuesEqual(v, value) || fmt.Sprint(v) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
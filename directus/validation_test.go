@@ -0,0 +1,103 @@
+package directus
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestMatchFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    string
+		filter   map[string]any
+		value    any
+		wantRule string
+		wantOk   bool
+	}{
+		{"eq", "code", map[string]any{"code": map[string]any{"_eq": "de"}}, "de", "", true},
+		{"eq fails", "code", map[string]any{"code": map[string]any{"_eq": "de"}}, "en", "_eq", false},
+		{"eq number across types", "port", map[string]any{"port": map[string]any{"_eq": float64(80)}}, 80, "", true},
+		{"gte", "port", map[string]any{"port": map[string]any{"_gte": float64(1024)}}, 1024, "", true},
+		{"lt fails", "port", map[string]any{"port": map[string]any{"_lt": float64(10)}}, 10, "_lt", false},
+		{"in", "code", map[string]any{"code": map[string]any{"_in": []any{"de", "en"}}}, "en", "", true},
+		{"nin fails", "code", map[string]any{"code": map[string]any{"_nin": []any{"de", "en"}}}, "en", "_nin", false},
+		{"regex", "code", map[string]any{"code": map[string]any{"_regex": "/^[a-z]{2}$/"}}, "de", "", true},
+		{"regex fails", "code", map[string]any{"code": map[string]any{"_regex": "^[a-z]{2}$"}}, "deu", "_regex", false},
+		{"contains on number fails", "code", map[string]any{"code": map[string]any{"_contains": "1"}}, 1, "_contains", false},
+		{"decimal", "price", map[string]any{"price": map[string]any{"_gt": float64(0)}}, MustParseDecimal("0.01"), "", true},
+		{"and", "port", map[string]any{"_and": []any{
+			map[string]any{"port": map[string]any{"_gt": float64(0)}},
+			map[string]any{"port": map[string]any{"_lt": float64(100)}},
+		}}, 150, "_lt", false},
+		{"or", "code", map[string]any{"_or": []any{
+			map[string]any{"code": map[string]any{"_eq": "de"}},
+			map[string]any{"code": map[string]any{"_eq": "en"}},
+		}}, "en", "", true},
+		{"or fails", "code", map[string]any{"_or": []any{
+			map[string]any{"code": map[string]any{"_eq": "de"}},
+		}}, "en", "_eq", false},
+		{"other field ignored", "code", map[string]any{"name": map[string]any{"_eq": "x"}}, "y", "", true},
+		{"null", "code", map[string]any{"code": map[string]any{"_null": true}}, nil, "", true},
+		{"null fails", "code", map[string]any{"code": map[string]any{"_null": true}}, "de", "_null", false},
+		{"nnull fails", "code", map[string]any{"code": map[string]any{"_nnull": true}}, nil, "_nnull", false},
+		{"named string type", "status", map[string]any{"status": map[string]any{"_starts_with": "act"}}, DirectusUsersStatusActive, "", true},
+		{"unsupported operator passes", "code", map[string]any{"code": map[string]any{"_intersects": "x"}}, "y", "", true},
+	}
+	for _, tt := range tests {
+		rule, ok := matchFilter(tt.filter, tt.field, tt.value)
+		if rule != tt.wantRule || ok != tt.wantOk {
+			t.Errorf("%s: matchFilter = (%q, %v), want (%q, %v)", tt.name, rule, ok, tt.wantRule, tt.wantOk)
+		}
+	}
+}
+
+func TestCheckMaxLengthOfNamedString(t *testing.T) {
+	maxLength := 3
+	errs := ValidationErrors{}
+	fieldRules{field: "status", maxLength: &maxLength}.check("directus_users", DirectusUsersStatusActive, &errs)
+	if len(errs) != 1 || errs[0].Extensions.Type != "max_length" {
+		t.Errorf("errors = %v, want max_length", errs)
+	}
+}
+
+func TestCheckNullAgainstValidationRule(t *testing.T) {
+	errs := ValidationErrors{}
+	rules := fieldRules{field: "code", validation: map[string]any{"code": map[string]any{"_nnull": true}}}
+	rules.check("location", nil, &errs)
+	if len(errs) != 1 || errs[0].Extensions.Type != "nnull" {
+		t.Errorf("errors = %v, want nnull", errs)
+	}
+}
+
+func TestUnknownEnumDecodesButFailsValidation(t *testing.T) {
+	var slot Slot
+	if err := json.Unmarshal([]byte(`{"id":"2f1b6a4e-6a8e-4b8a-9d0c-3f5a1c2e7b90","status":"retired"}`), &slot); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if slot.Status == nil || *slot.Status != SlotStatus("retired") {
+		t.Fatalf("status = %v, want retired", slot.Status)
+	}
+	if err := validateEnums("slot", map[string]any{"status": slot.Status}); err == nil {
+		t.Error("validateEnums accepted unknown status")
+	}
+	if err := validateEnums("slot", map[string]any{"status": SlotStatusDraft}); err != nil {
+		t.Errorf("validateEnums(draft) = %v", err)
+	}
+}
+
+func TestRequiredFieldWithDefaultMayBeOmitted(t *testing.T) {
+	api := newTestApi(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"data":[
+			{"field":"status","type":"string","meta":{"required":true},"schema":{"default_value":"draft"}},
+			{"field":"password_base64","type":"string","meta":{"required":true},"schema":{"default_value":null}}
+		]}`)
+	}))
+	err := api.validatePayload(context.Background(), "slot", map[string]any{}, true)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Extensions.Field != "password_base64" {
+		t.Errorf("validatePayload = %v, want password_base64 required only", err)
+	}
+}